@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_account_audit_log_sinks" "my_sinks" {}
+
+output "audit_log_sinks" {
+  value = data.temporalcloud_account_audit_log_sinks.my_sinks.sinks
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+// Enabled service account API keys that expire before the end of the year.
+data "temporalcloud_apikeys" "expiring" {
+  owner_type     = "service-account"
+  disabled       = false
+  expires_before = "2026-01-01T00:00:00Z"
+}
+
+output "expiring_apikeys" {
+  value = { for key in data.temporalcloud_apikeys.expiring.apikeys : key.id => "${key.display_name} (${key.expiry_time})" }
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_custom_role" "auditor" {
+  name = "auditor"
+}
+
+output "auditor_role_id" {
+  value = data.temporalcloud_custom_role.auditor.id
+}
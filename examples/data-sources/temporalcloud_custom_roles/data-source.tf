@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_custom_roles" "all" {}
+
+output "custom_role_ids_by_name" {
+  value = { for role in data.temporalcloud_custom_roles.all.custom_roles : role.name => role.id }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_user" "contractor" {
+  id = "a1b2c3d4e5f6"
+}
+
+data "temporalcloud_effective_access" "contractor" {
+  principal_id   = data.temporalcloud_user.contractor.id
+  principal_type = "user"
+}
+
+check "contractor_has_no_admin_access" {
+  assert {
+    condition     = !data.temporalcloud_effective_access.contractor.implicit_namespace_admin
+    error_message = "Contractors must not be account owners or admins."
+  }
+
+  assert {
+    condition = alltrue([
+      for access in data.temporalcloud_effective_access.contractor.namespace_accesses : access.permission != "admin"
+    ])
+    error_message = "Contractors must not be namespace admins."
+  }
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_group" "admins" {
+  name = "admins"
+}
+
+output "admins_members_count" {
+  value = data.temporalcloud_group.admins.members_count
+}
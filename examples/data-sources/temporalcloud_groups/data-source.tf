@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_groups" "scim_engineering" {
+  name_prefix = "eng-"
+  group_type  = "scim"
+}
+
+output "scim_engineering_group_ids" {
+  value = data.temporalcloud_groups.scim_engineering.groups[*].id
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_namespace_capacity_info" "example" {
+  namespace = "my-namespace.my-account"
+}
+
+// Capacity values that can currently be provisioned for the namespace.
+locals {
+  available_values = [
+    for v in data.temporalcloud_namespace_capacity_info.example.valid_provisioned_values : v
+    if v <= data.temporalcloud_namespace_capacity_info.example.max_provisioned_value
+  ]
+}
+
+output "capacity_change_in_progress" {
+  value = try(data.temporalcloud_namespace_capacity_info.example.latest_request.state == "inprogress", false)
+}
+
+output "available_capacity_values" {
+  value = local.available_values
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_namespace_export_sink" "my_sink" {
+  namespace = "my-namespace.my-account"
+  sink_name = "my-sink"
+
+  // Fail the plan when the last health check reported the sink as unhealthy.
+  fail_on_unhealthy = true
+}
+
+output "export_sink_health" {
+  value = data.temporalcloud_namespace_export_sink.my_sink.health
+}
+
+output "export_sink_last_succeeded_time" {
+  value = data.temporalcloud_namespace_export_sink.my_sink.last_succeeded_time
+}
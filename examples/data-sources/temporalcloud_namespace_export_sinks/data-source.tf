@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_namespace_export_sinks" "my_sinks" {
+  namespace = "my-namespace.my-account"
+}
+
+check "namespace_has_enabled_export_sink" {
+  assert {
+    condition     = anytrue([for sink in data.temporalcloud_namespace_export_sinks.my_sinks.sinks : sink.enabled])
+    error_message = "Namespace my-namespace.my-account has no enabled export sink."
+  }
+}
+
+output "export_sinks" {
+  value = data.temporalcloud_namespace_export_sinks.my_sinks.sinks
+}
@@ -19,6 +19,14 @@ data "temporalcloud_service_account" "admin" {
   id = temporalcloud_service_account.global_service_account
 }
 
+data "temporalcloud_service_account" "ci" {
+  name = "ci-deployer"
+}
+
 output "service_account" {
   value = data.temporalcloud_service_account.admin
 }
+
+output "ci_service_account_id" {
+  value = data.temporalcloud_service_account.ci.id
+}
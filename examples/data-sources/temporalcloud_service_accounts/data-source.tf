@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_service_accounts" "ci" {
+  name_regex       = "^ci-"
+  namespace_id     = "my-namespace.account"
+  state            = "active"
+  namespace_scoped = true
+}
+
+output "ci_service_account_ids" {
+  value = data.temporalcloud_service_accounts.ci.service_accounts[*].id
+}
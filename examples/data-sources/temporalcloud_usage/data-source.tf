@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+// Usage of two namespaces for the first week of the month.
+data "temporalcloud_usage" "weekly" {
+  start_time = "2025-06-01T00:00:00Z"
+  end_time   = "2025-06-08T00:00:00Z"
+  namespaces = ["my-namespace.my-account", "other-namespace.my-account"]
+}
+
+output "weekly_actions_per_namespace" {
+  value = { for usage in data.temporalcloud_usage.weekly.namespace_usages : usage.namespace => usage.actions }
+}
+
+output "weekly_total_actions" {
+  value = data.temporalcloud_usage.weekly.total_actions
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_user" "jane" {
+  email = "Jane.Doe@example.com"
+}
+
+output "user_id" {
+  value = data.temporalcloud_user.jane.id
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_users" "namespace_admins" {
+  email_regex    = "@example\\.com$"
+  account_access = "admin"
+  namespace_id   = "my-namespace.account"
+  state          = "active"
+}
+
+output "namespace_admin_emails" {
+  value = data.temporalcloud_users.namespace_admins.users[*].email
+}
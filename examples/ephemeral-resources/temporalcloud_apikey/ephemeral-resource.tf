@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "deployer" {
+  name           = "deployer"
+  account_access = "Read"
+}
+
+// A short-lived API key that only exists while Terraform runs. The token is never
+// written to the plan or state, and the key is deleted when Terraform is done with it.
+ephemeral "temporalcloud_apikey" "deployer" {
+  display_name = "deployer-terraform-run"
+  owner_type   = "service-account"
+  owner_id     = temporalcloud_service_account.deployer.id
+  duration     = "30m"
+  renew        = true
+}
+
+// Use the token to configure a provider for downstream Temporal tooling.
+provider "temporalcloud" {
+  alias   = "deployer"
+  api_key = ephemeral.temporalcloud_apikey.deployer.token
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "worker" {
+  name           = "worker"
+  account_access = "Read"
+}
+
+// The token of this API key is never written to the state.
+resource "temporalcloud_apikey" "worker" {
+  display_name = "worker"
+  owner_type   = "service-account"
+  owner_id     = temporalcloud_service_account.worker.id
+  expiry_time  = "2026-01-01T00:00:00Z"
+  store_token  = false
+}
+
+ephemeral "temporalcloud_apikey_token" "worker" {
+  id = temporalcloud_apikey.worker.id
+}
+
+resource "aws_secretsmanager_secret" "worker" {
+  name = "temporal-worker-api-key"
+}
+
+// The token can only be read once, in the apply that creates the API key. Write it to
+// the secret through a write-only attribute, and replace the secret version with the key.
+// If that apply fails before the secret is written, the token is lost: replace the API key
+// with `terraform apply -replace=temporalcloud_apikey.worker` to get a new one.
+resource "aws_secretsmanager_secret_version" "worker" {
+  secret_id                = aws_secretsmanager_secret.worker.id
+  secret_string_wo         = ephemeral.temporalcloud_apikey_token.worker.token
+  secret_string_wo_version = 1
+
+  lifecycle {
+    replace_triggered_by = [temporalcloud_apikey.worker.id]
+  }
+}
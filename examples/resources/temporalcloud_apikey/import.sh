@@ -0,0 +1,13 @@
+# API keys can be imported to incorporate existing API keys into your Terraform pipeline.
+# To import an API key, you need
+# - a resource configuration in your Terraform configuration file/module to accept the imported API key. In the example below, the placeholder is "temporalcloud_apikey" "worker"
+# - the API key's ID, which is found using the Temporal Cloud CLI tcld apikey list. In the example below, this is 2f1b3b5e6c7d4e8f9a0b1c2d3e4f5a6b
+# The token of an imported API key cannot be retrieved, so `token` stays null. Set `store_token = false` to make that explicit.
+
+terraform import temporalcloud_apikey.worker 2f1b3b5e6c7d4e8f9a0b1c2d3e4f5a6b
+
+# Instead of the ID, an API key can be imported by its owner and display name, separated by a slash.
+# The owner is either the email address of a user or the name of a service account.
+
+terraform import temporalcloud_apikey.worker worker-service-account/worker
+terraform import temporalcloud_apikey.developer jane@example.com/developer
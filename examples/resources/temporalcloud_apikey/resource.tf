@@ -21,4 +21,13 @@ resource "temporalcloud_apikey" "global_apikey" {
   owner_id     = temporalcloud_service_account.global_service_account.id
   expiry_time  = "2024-11-01T00:00:00Z"
   disabled     = false
-}
\ No newline at end of file
+}
+// An API key that expires 90 days after it is created. A warning is shown when planning
+// within 14 days of the expiry.
+resource "temporalcloud_apikey" "worker_apikey" {
+  display_name          = "worker"
+  owner_type            = "service-account"
+  owner_id              = temporalcloud_service_account.global_service_account.id
+  expires_in            = "90d"
+  expiry_warning_window = "14d"
+}
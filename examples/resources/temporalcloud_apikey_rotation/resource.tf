@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "worker" {
+  name           = "worker"
+  account_access = "Read"
+}
+
+// Creates a new API key every 30 days. The two newest API keys stay enabled, so consumers
+// still using the previous token keep working until they pick up the current one. Older
+// API keys are disabled, and deleted after 7 days.
+resource "temporalcloud_apikey_rotation" "worker" {
+  owner_type          = "service-account"
+  owner_id            = temporalcloud_service_account.worker.id
+  display_name_prefix = "worker"
+  rotation_period     = "30d"
+  grace_period        = "7d"
+  key_count           = 2
+}
+
+output "worker_token" {
+  value     = temporalcloud_apikey_rotation.worker.current_token
+  sensitive = true
+}
@@ -31,3 +31,20 @@ resource "temporalcloud_group_access" "namespace_admin_group_access" {
     }
   ]
 }
+
+resource "temporalcloud_group" "google_group" {
+  name          = "platform"
+  group_type    = "google"
+  email_address = "platform@yourdomain.com"
+}
+
+# Adopt a group provisioned by the identity provider through SCIM, to manage its access.
+resource "temporalcloud_group" "scim_group" {
+  group_type = "scim"
+  idp_id     = "00g1a2b3c4d5e6f7g8h9"
+}
+
+resource "temporalcloud_group_access" "scim_group_access" {
+  id             = temporalcloud_group.scim_group.id
+  account_access = "read"
+}
@@ -0,0 +1,6 @@
+# Group members can be imported to incorporate existing group memberships into your Terraform pipeline.
+# To import a group member, you need
+# - a resource configuration in your Terraform configuration file/module to accept the imported group member. In the example below, the placeholder is "temporalcloud_group_member" "developer"
+# - the group ID and the user ID, separated by a slash.
+
+terraform import temporalcloud_group_member.developer d4e5f6a1b2c3/a1b2c3d4e5f6
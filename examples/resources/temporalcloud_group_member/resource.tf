@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_user" "developer" {
+  email          = "developer@yourdomain.com"
+  account_access = "read"
+}
+
+# The group is owned by the platform team, application teams only add their own members.
+resource "temporalcloud_group_member" "developer" {
+  group_id = "d4e5f6a1b2c3"
+  user_id  = temporalcloud_user.developer.id
+}
@@ -29,4 +29,19 @@ resource "temporalcloud_group_members" "admin_group_members" {
   users = [
     temporalcloud_user.reader.id,
   ]
-}
\ No newline at end of file
+}
+
+resource "temporalcloud_group" "dev_group" {
+  name = "developers"
+}
+
+# Members can also be listed by email. Emails without a user fail the plan,
+# unless invite_missing_users invites them with an account_access of none.
+# Invited users are deleted when they are removed from the group.
+resource "temporalcloud_group_members" "dev_group_members" {
+  group_id = temporalcloud_group.dev_group.id
+  member_emails = [
+    "developer@yourdomain.com",
+  ]
+  invite_missing_users = true
+}
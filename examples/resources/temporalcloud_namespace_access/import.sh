@@ -0,0 +1,6 @@
+# Namespace accesses can be imported to incorporate existing namespace permissions into your Terraform pipeline.
+# To import a namespace access, you need
+# - a resource configuration in your Terraform configuration file/module to accept the imported namespace access. In the example below, the placeholder is "temporalcloud_namespace_access" "ci_orders"
+# - the namespace ID, the principal type (user, service-account or group) and the principal ID, separated by slashes.
+
+terraform import temporalcloud_namespace_access.ci_orders orders.deadbeef/service-account/a1b2c3d4e5f6
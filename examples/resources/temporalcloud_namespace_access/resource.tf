@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_namespace" "orders" {
+  name           = "orders"
+  regions        = ["aws-us-east-1"]
+  api_key_auth   = true
+  retention_days = 14
+}
+
+# The service account is owned by another module, which ignores changes to its namespace accesses.
+data "temporalcloud_service_account" "ci" {
+  id = "a1b2c3d4e5f6"
+}
+
+resource "temporalcloud_namespace_access" "ci_orders" {
+  namespace_id   = temporalcloud_namespace.orders.id
+  principal_id   = data.temporalcloud_service_account.ci.id
+  principal_type = "service-account"
+  permission     = "write"
+}
@@ -31,4 +31,11 @@ resource "temporalcloud_user" "namespace_admin" {
       permission   = "admin"
     }
   ]
-}
\ No newline at end of file
+}
+# A user provisioned through SCIM derives its access from its groups in the
+# identity provider, so Terraform leaves that access alone.
+resource "temporalcloud_user" "scim_user" {
+  email                      = "engineer@yourdomain.com"
+  account_access             = "none"
+  ignore_scim_managed_fields = true
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    temporalcloud = {
+      source = "temporalio/temporalcloud"
+    }
+  }
+}
+
+provider "temporalcloud" {
+
+}
+
+# users.csv:
+#
+# email,account_access,namespace_id,permission
+# alice@yourdomain.com,developer,terraform.abc12,write
+# bob@yourdomain.com,read,,
+locals {
+  roster = csvdecode(file("${path.module}/users.csv"))
+}
+
+resource "temporalcloud_users_roster" "engineering" {
+  users = [
+    for user in local.roster : {
+      email          = user.email
+      account_access = user.account_access
+      namespace_accesses = user.namespace_id == "" ? null : [
+        {
+          namespace_id = user.namespace_id
+          permission   = user.permission
+        }
+      ]
+    }
+  ]
+  max_concurrency = 10
+}
@@ -38,12 +38,20 @@ type (
 	}
 )
 
-func accountAuditLogSinkDataSourceSchema() map[string]schema.Attribute {
+func accountAuditLogSinkDataSourceSchema(nameRequired bool) map[string]schema.Attribute {
+	sinkNameAttribute := schema.StringAttribute{
+		Description: "The unique name of the audit log sink.",
+	}
+
+	switch nameRequired {
+	case true:
+		sinkNameAttribute.Required = true
+	case false:
+		sinkNameAttribute.Computed = true
+	}
+
 	return map[string]schema.Attribute{
-		"sink_name": schema.StringAttribute{
-			Description: "The unique name of the audit log sink.",
-			Required:    true,
-		},
+		"sink_name": sinkNameAttribute,
 		"enabled": schema.BoolAttribute{
 			Description: "A flag indicating whether the audit log sink is enabled or not.",
 			Computed:    true,
@@ -116,7 +124,7 @@ func (d *accountAuditLogSinkDataSource) Configure(ctx context.Context, req datas
 func (d *accountAuditLogSinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Fetches details about an account audit log sink.",
-		Attributes:  accountAuditLogSinkDataSourceSchema(),
+		Attributes:  accountAuditLogSinkDataSourceSchema(true),
 	}
 }
 
@@ -163,33 +171,16 @@ func accountAuditLogSinkToAccountAuditLogSinkDataModel(ctx context.Context, audi
 	model.Enabled = types.BoolValue(auditLogSink.GetSpec().GetEnabled())
 	model.State = types.StringValue(stateStr)
 
-	kinesisObj := types.ObjectNull(internaltypes.KinesisSpecModelAttrTypes)
-	if auditLogSink.GetSpec().GetKinesisSink() != nil {
-		kinesisSpec := internaltypes.KinesisSpecModel{
-			RoleName:       types.StringValue(auditLogSink.GetSpec().GetKinesisSink().GetRoleName()),
-			DestinationUri: types.StringValue(auditLogSink.GetSpec().GetKinesisSink().GetDestinationUri()),
-			Region:         types.StringValue(auditLogSink.GetSpec().GetKinesisSink().GetRegion()),
-		}
-
-		kinesisObj, diags = types.ObjectValueFrom(ctx, internaltypes.KinesisSpecModelAttrTypes, kinesisSpec)
-		if diags.HasError() {
-			return nil, diags
-		}
+	kinesisObj, d := internaltypes.KinesisSpecToObject(ctx, auditLogSink.GetSpec().GetKinesisSink())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	pubsubObj := types.ObjectNull(internaltypes.PubSubSpecModelAttrTypes)
-	if auditLogSink.GetSpec().GetPubSubSink() != nil {
-		pubsubSpec := internaltypes.PubSubSpecModel{
-			ServiceAccountId:    types.StringValue(auditLogSink.GetSpec().GetPubSubSink().GetServiceAccountId()),
-			TopicName:           types.StringValue(auditLogSink.GetSpec().GetPubSubSink().GetTopicName()),
-			GcpProjectId:        types.StringValue(auditLogSink.GetSpec().GetPubSubSink().GetGcpProjectId()),
-			ServiceAccountEmail: types.StringValue(fmt.Sprintf("%s@%s.iam.gserviceaccount.com", auditLogSink.GetSpec().GetPubSubSink().GetServiceAccountId(), auditLogSink.GetSpec().GetPubSubSink().GetGcpProjectId())),
-		}
-
-		pubsubObj, diags = types.ObjectValueFrom(ctx, internaltypes.PubSubSpecModelAttrTypes, pubsubSpec)
-		if diags.HasError() {
-			return nil, diags
-		}
+	pubsubObj, d := internaltypes.PubSubSpecToObject(ctx, auditLogSink.GetSpec().GetPubSubSink())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	model.Kinesis = kinesisObj
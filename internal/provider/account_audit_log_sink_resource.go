@@ -25,11 +25,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -39,7 +41,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 	"google.golang.org/grpc/codes"
@@ -48,7 +49,6 @@ import (
 	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
 	accountv1 "go.temporal.io/cloud-sdk/api/account/v1"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
-	sinkv1 "go.temporal.io/cloud-sdk/api/sink/v1"
 )
 
 const (
@@ -171,6 +171,12 @@ func (r *accountAuditLogSinkResource) Schema(ctx context.Context, req resource.S
 						Description: "The service account email associated with the PubSub topic and service account.",
 						Optional:    true,
 						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^(\S+)@(\S+).iam.gserviceaccount.com$`),
+								"Service account email must be in the format of '<sa>@<gcp_project>.iam.gserviceaccount.com' where <sa> is the service account ID and <gcp_project> is a valid GCP project ID",
+							),
+						},
 					},
 				},
 				Validators: []validator.Object{
@@ -246,34 +252,16 @@ func (r *accountAuditLogSinkResource) Create(ctx context.Context, req resource.C
 func updateAccountAuditLogSinkModelFromSpec(ctx context.Context, state *accountAuditLogSinkResourceModel, sink *accountv1.AuditLogSink) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	kinesisObj := types.ObjectNull(internaltypes.KinesisSpecModelAttrTypes)
-	if sink.GetSpec().GetKinesisSink() != nil {
-		kinesisSpec := internaltypes.KinesisSpecModel{
-			RoleName:       types.StringValue(sink.GetSpec().GetKinesisSink().GetRoleName()),
-			DestinationUri: types.StringValue(sink.GetSpec().GetKinesisSink().GetDestinationUri()),
-			Region:         types.StringValue(sink.GetSpec().GetKinesisSink().GetRegion()),
-		}
-
-		kinesisObj, diags = types.ObjectValueFrom(ctx, internaltypes.KinesisSpecModelAttrTypes, kinesisSpec)
-		if diags.HasError() {
-			return diags
-		}
+	kinesisObj, d := internaltypes.KinesisSpecToObject(ctx, sink.GetSpec().GetKinesisSink())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
 
-	pubsubObj := types.ObjectNull(internaltypes.PubSubSpecModelAttrTypes)
-	if sink.GetSpec().GetPubSubSink() != nil {
-		saEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", sink.GetSpec().GetPubSubSink().GetServiceAccountId(), sink.GetSpec().GetPubSubSink().GetGcpProjectId())
-		pubsubSpec := internaltypes.PubSubSpecModel{
-			ServiceAccountId:    types.StringValue(sink.GetSpec().GetPubSubSink().GetServiceAccountId()),
-			TopicName:           types.StringValue(sink.GetSpec().GetPubSubSink().GetTopicName()),
-			GcpProjectId:        types.StringValue(sink.GetSpec().GetPubSubSink().GetGcpProjectId()),
-			ServiceAccountEmail: types.StringValue(saEmail),
-		}
-
-		pubsubObj, diags = types.ObjectValueFrom(ctx, internaltypes.PubSubSpecModelAttrTypes, pubsubSpec)
-		if diags.HasError() {
-			return diags
-		}
+	pubsubObj, d := internaltypes.PubSubSpecToObject(ctx, sink.GetSpec().GetPubSubSink())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
 
 	state.SinkName = types.StringValue(sink.GetName())
@@ -357,61 +345,37 @@ func getAccountAuditLogSinkSpecFromModel(ctx context.Context, plan *accountAudit
 		return nil, diags
 	}
 
-	if !plan.Kinesis.IsNull() {
-		var kinesisSpec internaltypes.KinesisSpecModel
-		diags.Append(plan.Kinesis.As(ctx, &kinesisSpec, basetypes.ObjectAsOptions{})...)
-		if diags.HasError() {
-			return nil, diags
-		}
+	kinesisSpec, d := internaltypes.KinesisSpecFromObject(ctx, plan.Kinesis)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-		kinesisSinkSpec := &sinkv1.KinesisSpec{
-			RoleName:       kinesisSpec.RoleName.ValueString(),
-			DestinationUri: kinesisSpec.DestinationUri.ValueString(),
-			Region:         kinesisSpec.Region.ValueString(),
-		}
+	pubsubSpec, d := internaltypes.PubSubSpecFromObject(ctx, plan.PubSub)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-		return &accountv1.AuditLogSinkSpec{
-			Name:    plan.SinkName.ValueString(),
-			Enabled: plan.Enabled.ValueBool(),
-			SinkType: &accountv1.AuditLogSinkSpec_KinesisSink{
-				KinesisSink: kinesisSinkSpec,
-			},
-		}, nil
-	} else if !plan.PubSub.IsNull() {
-		var pubsubSpec internaltypes.PubSubSpecModel
-		diags.Append(plan.PubSub.As(ctx, &pubsubSpec, basetypes.ObjectAsOptions{})...)
-		if diags.HasError() {
-			return nil, diags
-		}
-		saId := pubsubSpec.ServiceAccountId.ValueString()
-		gcpProjectId := pubsubSpec.GcpProjectId.ValueString()
-		if saId == "" && gcpProjectId == "" && pubsubSpec.ServiceAccountEmail.ValueString() != "" {
-			saId, gcpProjectId = parseSAPrincipal(pubsubSpec.ServiceAccountEmail.ValueString())
-		}
+	spec := &accountv1.AuditLogSinkSpec{
+		Name:    plan.SinkName.ValueString(),
+		Enabled: plan.Enabled.ValueBool(),
+	}
 
-		if saId == "" || gcpProjectId == "" {
-			diags.AddError(
-				"Missing Service Account Configuration",
-				"Either provide both service_account_id and gcp_project_id, or provide a valid service_account_email",
-			)
-			return nil, diags
+	switch {
+	case kinesisSpec != nil:
+		spec.SinkType = &accountv1.AuditLogSinkSpec_KinesisSink{
+			KinesisSink: kinesisSpec,
 		}
-		pubsubSinkSpec := &sinkv1.PubSubSpec{
-			ServiceAccountId: saId,
-			TopicName:        pubsubSpec.TopicName.ValueString(),
-			GcpProjectId:     gcpProjectId,
+	case pubsubSpec != nil:
+		spec.SinkType = &accountv1.AuditLogSinkSpec_PubSubSink{
+			PubSubSink: pubsubSpec,
 		}
-
-		return &accountv1.AuditLogSinkSpec{
-			Name:    plan.SinkName.ValueString(),
-			Enabled: plan.Enabled.ValueBool(),
-			SinkType: &accountv1.AuditLogSinkSpec_PubSubSink{
-				PubSubSink: pubsubSinkSpec,
-			},
-		}, nil
+	default:
+		return nil, diags
 	}
 
-	return nil, diags
+	return spec, diags
 }
 
 func (r *accountAuditLogSinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
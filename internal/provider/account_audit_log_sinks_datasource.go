@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+
+	accountv1 "go.temporal.io/cloud-sdk/api/account/v1"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+)
+
+type (
+	accountAuditLogSinksDataSource struct {
+		client *client.Client
+	}
+
+	accountAuditLogSinksDataModel struct {
+		ID    types.String                   `tfsdk:"id"`
+		Sinks []accountAuditLogSinkDataModel `tfsdk:"sinks"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*accountAuditLogSinksDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*accountAuditLogSinksDataSource)(nil)
+)
+
+func NewAccountAuditLogSinksDataSource() datasource.DataSource {
+	return &accountAuditLogSinksDataSource{}
+}
+
+func (d *accountAuditLogSinksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_audit_log_sinks"
+}
+
+func (d *accountAuditLogSinksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *accountAuditLogSinksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about all account audit log sinks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the Account Audit Log Sinks data source.",
+				Computed:    true,
+			},
+			"sinks": schema.ListNestedAttribute{
+				Description: "The list of account audit log sinks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accountAuditLogSinkDataSourceSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *accountAuditLogSinksDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state accountAuditLogSinksDataModel
+
+	var sinks []*accountv1.AuditLogSink
+	pageToken := ""
+	for {
+		r, err := d.client.CloudService().GetAccountAuditLogSinks(ctx, &cloudservicev1.GetAccountAuditLogSinksRequest{PageToken: pageToken})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch account audit log sinks", err.Error())
+			return
+		}
+
+		sinks = append(sinks, r.GetSinks()...)
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		pageToken = r.GetNextPageToken()
+	}
+
+	state.Sinks = make([]accountAuditLogSinkDataModel, 0, len(sinks))
+	for _, sink := range sinks {
+		sinkModel, diags := accountAuditLogSinkToAccountAuditLogSinkDataModel(ctx, sink)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Sinks = append(state.Sinks, *sinkModel)
+	}
+
+	accResp, err := d.client.CloudService().GetAccount(ctx, &cloudservicev1.GetAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get account information.", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("account-%s-audit-log-sinks", accResp.GetAccount().GetId()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
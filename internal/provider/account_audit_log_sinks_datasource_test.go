@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSource_AccountAuditLogSinks(t *testing.T) {
+	sinkName := fmt.Sprintf("tf-test-sink-%s", randomString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAuditLogSinksConfig(sinkName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.temporalcloud_account_audit_log_sinks.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.temporalcloud_account_audit_log_sinks.test", "sinks.*", map[string]string{
+						"sink_name":         sinkName,
+						"enabled":           "true",
+						"kinesis.role_name": "test-role",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountAuditLogSinksConfig(sinkName string) string {
+	return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_account_audit_log_sink" "test" {
+  sink_name = %[1]q
+  enabled   = true
+  kinesis = {
+    role_name       = "test-role"
+    destination_uri = "test-uri"
+    region          = "ca-central-1"
+  }
+}
+
+data "temporalcloud_account_audit_log_sinks" "test" {
+  depends_on = [temporalcloud_account_audit_log_sink.test]
+}
+`, sinkName)
+}
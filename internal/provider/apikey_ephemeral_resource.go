@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/validators"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+const (
+	defaultEphemeralApiKeyDuration = time.Hour
+
+	// apiKeyEphemeralPrivateKey is the private data key under which the ephemeral API key is tracked
+	// between Open, Renew and Close.
+	apiKeyEphemeralPrivateKey = "apikey"
+)
+
+type (
+	apiKeyEphemeralResource struct {
+		client *client.Client
+	}
+
+	apiKeyEphemeralResourceModel struct {
+		ID             types.String `tfsdk:"id"`
+		OwnerType      types.String `tfsdk:"owner_type"`
+		OwnerID        types.String `tfsdk:"owner_id"`
+		DisplayName    types.String `tfsdk:"display_name"`
+		Description    types.String `tfsdk:"description"`
+		Duration       types.String `tfsdk:"duration"`
+		Renew          types.Bool   `tfsdk:"renew"`
+		DisableOnClose types.Bool   `tfsdk:"disable_on_close"`
+		ExpiryTime     types.String `tfsdk:"expiry_time"`
+		Token          types.String `tfsdk:"token"`
+	}
+
+	// apiKeyEphemeralPrivateData is the data carried from Open to Renew and Close.
+	apiKeyEphemeralPrivateData struct {
+		KeyID          string        `json:"key_id"`
+		Duration       time.Duration `json:"duration"`
+		Renew          bool          `json:"renew"`
+		DisableOnClose bool          `json:"disable_on_close"`
+	}
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure      = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithRenew          = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose          = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*apiKeyEphemeralResource)(nil)
+)
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+func (r *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey"
+}
+
+func (r *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived Temporal Cloud API key for the duration of a Terraform run. The token is never persisted to the plan or state, and the API key is deleted (or disabled) when Terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API key.",
+				Computed:    true,
+			},
+			"owner_type": schema.StringAttribute{
+				Description: "The type of the owner to create the API key.",
+				Required:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the owner to create the API key for.",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name for the API key.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description for the API key.",
+				Optional:    true,
+			},
+			"duration": schema.StringAttribute{
+				Description: "How long the API key is valid for after it is created or renewed, as a Go duration string that may start with a number of days (for example `30m`, `2h` or `1d`). Defaults to `1h`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"renew": schema.BoolAttribute{
+				Description: "If set to true, the expiry time of the API key is extended by `duration` when Terraform is still using it halfway to expiry. Defaults to false.",
+				Optional:    true,
+			},
+			"disable_on_close": schema.BoolAttribute{
+				Description: "If set to true, the API key is disabled instead of deleted when Terraform no longer needs it. Defaults to false.",
+				Optional:    true,
+			},
+			"expiry_time": schema.StringAttribute{
+				Description: "The expiry time for the API key in ISO 8601 format.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The token for the API key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the expiry of the API key before it is created.
+func (r *apiKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var duration types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration"), &duration)...)
+	if resp.Diagnostics.HasError() || duration.IsNull() || duration.IsUnknown() {
+		return
+	}
+
+	d, err := validators.ParseDuration(duration.ValueString())
+	if err != nil {
+		// Reported by the validator of the attribute.
+		return
+	}
+	now := time.Now()
+	resp.Diagnostics.Append(validateApiKeyExpiry(path.Root("duration"), now.Add(d), now)...)
+}
+
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	duration := defaultEphemeralApiKeyDuration
+	if !data.Duration.IsNull() {
+		var err error
+		duration, err = validators.ParseDuration(data.Duration.ValueString())
+		if err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid duration", fmt.Sprintf("duration must be a positive duration such as 30m or 1d, got %q", data.Duration.ValueString()))
+			return
+		}
+	}
+
+	ownerType, err := enums.ToOwnerType(data.OwnerType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+		return
+	}
+
+	expiryTime := time.Now().Add(duration)
+	svcResp, err := r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
+		Spec: &identityv1.ApiKeySpec{
+			OwnerId:     data.OwnerID.ValueString(),
+			OwnerType:   ownerType,
+			DisplayName: data.DisplayName.ValueString(),
+			Description: data.Description.ValueString(),
+			ExpiryTime:  timestamppb.New(expiryTime),
+		},
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create API key", err.Error())
+		return
+	}
+
+	// Close is not called when Open fails, so delete the API key here to not leave it live in the account.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := deleteEphemeralApiKey(ctx, r.client, svcResp.GetKeyId()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to delete API key after failed open",
+				fmt.Sprintf("API key `%s` was created but could not be deleted, delete it manually: %s", svcResp.GetKeyId(), err.Error()),
+			)
+		}
+	}()
+
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		resp.Diagnostics.AddError("Failed to create API key", err.Error())
+		return
+	}
+
+	private := apiKeyEphemeralPrivateData{
+		KeyID:          svcResp.GetKeyId(),
+		Duration:       duration,
+		Renew:          data.Renew.ValueBool(),
+		DisableOnClose: data.DisableOnClose.ValueBool(),
+	}
+	resp.Diagnostics.Append(setApiKeyEphemeralPrivateData(ctx, resp.Private, private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(svcResp.GetKeyId())
+	data.ExpiryTime = types.StringValue(expiryTime.UTC().Format(time.RFC3339))
+	data.Token = types.StringValue(svcResp.GetToken())
+	if private.Renew {
+		resp.RenewAt = time.Now().Add(duration / 2)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *apiKeyEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	private, diags := getApiKeyEphemeralPrivateData(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil || !private.Renew {
+		return
+	}
+
+	apiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: private.KeyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get current API key status", err.Error())
+		return
+	}
+
+	spec := apiKey.GetApiKey().GetSpec()
+	spec.ExpiryTime = timestamppb.New(time.Now().Add(private.Duration))
+	svcResp, err := r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
+		KeyId:            private.KeyID,
+		Spec:             spec,
+		ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to renew API key", err.Error())
+		return
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		resp.Diagnostics.AddError("Failed to renew API key", err.Error())
+		return
+	}
+
+	resp.RenewAt = time.Now().Add(private.Duration / 2)
+}
+
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := getApiKeyEphemeralPrivateData(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	apiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: private.KeyID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			tflog.Warn(ctx, "Ephemeral API Key not found, skipping close", map[string]interface{}{
+				"id": private.KeyID,
+			})
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to get current API key status", err.Error())
+		return
+	}
+
+	if private.DisableOnClose {
+		spec := apiKey.GetApiKey().GetSpec()
+		spec.Disabled = true
+		svcResp, err := r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
+			KeyId:            private.KeyID,
+			Spec:             spec,
+			ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to disable API key", err.Error())
+			return
+		}
+		if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+			resp.Diagnostics.AddError("Failed to disable API key", err.Error())
+		}
+		return
+	}
+
+	svcResp, err := r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+		KeyId:            private.KeyID,
+		ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			tflog.Warn(ctx, "Ephemeral API Key not found, skipping close", map[string]interface{}{
+				"id": private.KeyID,
+			})
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to delete API key", err.Error())
+		return
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete API key", err.Error())
+	}
+}
+
+// deleteEphemeralApiKey deletes the API key with the given ID, ignoring API keys that no longer exist.
+func deleteEphemeralApiKey(ctx context.Context, c *client.Client, keyID string) error {
+	apiKey, err := c.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	svcResp, err := c.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+		KeyId:            keyID,
+		ResourceVersion:  apiKey.GetApiKey().GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+	return client.AwaitAsyncOperation(ctx, c, svcResp.GetAsyncOperation())
+}
+
+// privateDataStore is implemented by the private state passed to ephemeral resource operations.
+type privateDataStore interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func setApiKeyEphemeralPrivateData(ctx context.Context, store privateDataStore, data apiKeyEphemeralPrivateData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	b, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Failed to encode ephemeral API key private data", err.Error())
+		return diags
+	}
+
+	return store.SetKey(ctx, apiKeyEphemeralPrivateKey, b)
+}
+
+func getApiKeyEphemeralPrivateData(ctx context.Context, store privateDataStore) (*apiKeyEphemeralPrivateData, diag.Diagnostics) {
+	b, diags := store.GetKey(ctx, apiKeyEphemeralPrivateKey)
+	if diags.HasError() || len(b) == 0 {
+		return nil, diags
+	}
+
+	var data apiKeyEphemeralPrivateData
+	if err := json.Unmarshal(b, &data); err != nil {
+		diags.AddError("Failed to decode ephemeral API key private data", err.Error())
+		return nil, diags
+	}
+
+	return &data, diags
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestApiKeyEphemeralResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := ephemeral.SchemaRequest{}
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewApiKeyEphemeralResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAccEphemeralApiKey(t *testing.T) {
+	apiKeyName := createRandomApiKeyName()
+	serviceAccountName := createRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"temporalcloud": testAccProtoV6ProviderFactories["temporalcloud"],
+			"echo":          echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "test" {
+  name           = "%s"
+  account_access = "Read"
+}
+
+ephemeral "temporalcloud_apikey" "test" {
+  display_name = "%s"
+  owner_type   = "service-account"
+  owner_id     = temporalcloud_service_account.test.id
+  duration     = "15m"
+}
+
+provider "echo" {
+  data = ephemeral.temporalcloud_apikey.test
+}
+
+resource "echo" "test" {}
+`, serviceAccountName, apiKeyName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("display_name"), knownvalue.StringExact(apiKeyName)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralApiKey_InvalidDuration(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"temporalcloud": testAccProtoV6ProviderFactories["temporalcloud"],
+			"echo":          echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+ephemeral "temporalcloud_apikey" "test" {
+  display_name = "%s"
+  owner_type   = "service-account"
+  owner_id     = "unused"
+  duration     = "1w"
+}
+
+provider "echo" {
+  data = ephemeral.temporalcloud_apikey.test
+}
+
+resource "echo" "test" {}
+`, createRandomApiKeyName()),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+ephemeral "temporalcloud_apikey" "test" {
+  display_name = "%s"
+  owner_type   = "service-account"
+  owner_id     = "unused"
+  duration     = "1000d"
+}
+
+provider "echo" {
+  data = ephemeral.temporalcloud_apikey.test
+}
+
+resource "echo" "test" {}
+`, createRandomApiKeyName()),
+				ExpectError: regexp.MustCompile("Invalid API key expiry"),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,40 +13,58 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/validators"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxApiKeyExpiry is the longest time from now an API key may expire in. It mirrors the maximum API key
+// expiration of two years documented at https://docs.temporal.io/cloud/api-keys, to reject expiry times when
+// planning. The API remains authoritative: if the account maximum changes, the API rejects the key on apply,
+// and this constant has to follow.
+const maxApiKeyExpiry = 2 * 365 * 24 * time.Hour
+
 type (
 	apiKeyResource struct {
 		client *client.Client
 	}
 
 	apiKeyResourceModel struct {
-		ID          types.String   `tfsdk:"id"`
-		State       types.String   `tfsdk:"state"`
-		OwnerType   types.String   `tfsdk:"owner_type"`
-		OwnerID     types.String   `tfsdk:"owner_id"`
-		DisplayName types.String   `tfsdk:"display_name"`
-		Token       types.String   `tfsdk:"token"`
-		Description types.String   `tfsdk:"description"`
-		ExpiryTime  types.String   `tfsdk:"expiry_time"` // ISO 8601 format
-		Disabled    types.Bool     `tfsdk:"disabled"`
-		Timeouts    timeouts.Value `tfsdk:"timeouts"`
+		ID                  types.String   `tfsdk:"id"`
+		State               types.String   `tfsdk:"state"`
+		OwnerType           types.String   `tfsdk:"owner_type"`
+		OwnerID             types.String   `tfsdk:"owner_id"`
+		DisplayName         types.String   `tfsdk:"display_name"`
+		Token               types.String   `tfsdk:"token"`
+		Description         types.String   `tfsdk:"description"`
+		ExpiryTime          types.String   `tfsdk:"expiry_time"` // ISO 8601 format
+		ExpiresIn           types.String   `tfsdk:"expires_in"`
+		Disabled            types.Bool     `tfsdk:"disabled"`
+		StoreToken          types.Bool     `tfsdk:"store_token"`
+		Expired             types.Bool     `tfsdk:"expired"`
+		DaysUntilExpiry     types.Int64    `tfsdk:"days_until_expiry"`
+		ExpiryWarningWindow types.String   `tfsdk:"expiry_warning_window"`
+		Timeouts            timeouts.Value `tfsdk:"timeouts"`
 	}
 )
 
 var (
-	_ resource.Resource              = (*apiKeyResource)(nil)
-	_ resource.ResourceWithConfigure = (*apiKeyResource)(nil)
+	_ resource.Resource                = (*apiKeyResource)(nil)
+	_ resource.ResourceWithConfigure   = (*apiKeyResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*apiKeyResource)(nil)
+	_ resource.ResourceWithImportState = (*apiKeyResource)(nil)
 )
 
 func NewApiKeyResource() resource.Resource {
@@ -100,10 +119,14 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"owner_id": schema.StringAttribute{
-				Description: "The ID of the owner to create the API key for.",
+				Description: "The ID of the owner to create the API key for. For users, the email address of the user is accepted as well and resolved to the user ID when planning.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// Changes between a user ID and the email of the same user do not replace the API key,
+					// these are resolved in ModifyPlan instead.
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !isEmail(req.StateValue.ValueString()) && !isEmail(req.PlanValue.ValueString())
+					}, "Changing the owner replaces the API key.", "Changing the owner replaces the API key."),
 				},
 			},
 			"display_name": schema.StringAttribute{
@@ -111,7 +134,7 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Required:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "The token for the API key. This field is populated with the full key when creating an API key. To retrieve the value of this field, use an output.tf file and follow Terraform's guidance on working with sensitive fields.",
+				Description: "The token for the API key. This field is populated with the full key when creating an API key, unless `store_token` is false. To retrieve the value of this field, use an output.tf file and follow Terraform's guidance on working with sensitive fields. The token cannot be retrieved for imported API keys.",
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
@@ -123,11 +146,41 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"expiry_time": schema.StringAttribute{
-				Description: "The expiry time for the API key in ISO 8601 format.",
-				Required:    true,
+				Description: "The expiry time for the API key in ISO 8601 format. Exactly one of `expiry_time` or `expires_in` must be set.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("expires_in")),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "How long after creation the API key expires, such as `90d` or `12h`. The resulting time is stored in `expiry_time` when the API key is created, and changing this value replaces the API key. Exactly one of `expiry_time` or `expires_in` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether the API key has expired, as of the last refresh.",
+				Computed:    true,
+			},
+			"days_until_expiry": schema.Int64Attribute{
+				Description: "The number of full days until the API key expires, as of the last refresh. 0 once the API key has expired.",
+				Computed:    true,
+			},
+			"expiry_warning_window": schema.StringAttribute{
+				Description: "If set, a warning is shown when planning while the API key expires within this duration, such as `30d`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
 			},
 			"disabled": schema.BoolAttribute{
 				Description: "Whether the API key is disabled.",
@@ -135,6 +188,12 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"store_token": schema.BoolAttribute{
+				Description: "Whether the token is stored in the Terraform state. If set to false, `token` is always null and the token can only be read once through the `temporalcloud_apikey_token` ephemeral resource, in the same apply that creates the API key. If it is not stored in that apply, the API key has to be replaced to get a new token. Setting this to false on an existing API key removes its token from the state. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -145,6 +204,183 @@ func (r *apiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is carried over from the state by UseStateForUnknown, so it has to be removed from
+	// the plan explicitly when the token should no longer be stored.
+	if !plan.StoreToken.IsUnknown() && !plan.StoreToken.ValueBool() && !plan.Token.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringNull())...)
+	}
+
+	resp.Diagnostics.Append(r.modifyOwnerPlan(ctx, req, plan, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	if !req.State.Raw.IsNull() {
+		// The API key exists, the expiry time is only checked against the warning window.
+		if plan.ExpiryWarningWindow.IsNull() || plan.ExpiryWarningWindow.IsUnknown() || plan.ExpiryTime.IsUnknown() {
+			return
+		}
+		window, err := validators.ParseDuration(plan.ExpiryWarningWindow.ValueString())
+		if err != nil {
+			return
+		}
+		expiryTime, err := time.Parse(time.RFC3339, plan.ExpiryTime.ValueString())
+		if err != nil {
+			return
+		}
+		resp.Diagnostics.Append(apiKeyExpiryWarning(plan.DisplayName.ValueString(), expiryTime, now, window)...)
+		return
+	}
+
+	// The API key is created, validate the expiry time it will get. With expires_in, expiry_time is left unknown
+	// and only resolved in Create, as it depends on the time of the apply, which ModifyPlan runs again for.
+	expiryPath := path.Root("expiry_time")
+	var expiryTime time.Time
+	switch {
+	case !plan.ExpiresIn.IsNull():
+		if plan.ExpiresIn.IsUnknown() {
+			return
+		}
+		expiresIn, err := validators.ParseDuration(plan.ExpiresIn.ValueString())
+		if err != nil {
+			return
+		}
+		expiryPath = path.Root("expires_in")
+		expiryTime = now.Add(expiresIn)
+	case !plan.ExpiryTime.IsUnknown() && !plan.ExpiryTime.IsNull():
+		var err error
+		expiryTime, err = time.Parse(time.RFC3339, plan.ExpiryTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(expiryPath, "Invalid expiry_time", fmt.Sprintf("expiry_time must be formatted as RFC3339: %s", err.Error()))
+			return
+		}
+	default:
+		return
+	}
+
+	resp.Diagnostics.Append(validateApiKeyExpiry(expiryPath, expiryTime, now)...)
+}
+
+// modifyOwnerPlan resolves an owner_id given as email address, failing the plan if there is no such user,
+// and replaces the API key if the resolved owner differs from the owner in the state.
+func (r *apiKeyResource) modifyOwnerPlan(ctx context.Context, req resource.ModifyPlanRequest, plan apiKeyResourceModel, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || plan.OwnerID.IsUnknown() || plan.OwnerType.IsUnknown() {
+		return diags
+	}
+
+	var stateOwnerID types.String
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("owner_id"), &stateOwnerID)...)
+		if diags.HasError() || stateOwnerID.Equal(plan.OwnerID) {
+			return diags
+		}
+	}
+	if !isEmail(plan.OwnerID.ValueString()) && !isEmail(stateOwnerID.ValueString()) {
+		return diags
+	}
+
+	ownerID, err := resolveApiKeyOwnerID(ctx, r.client, plan.OwnerType.ValueString(), plan.OwnerID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("owner_id"), "Failed to resolve API key owner", err.Error())
+		return diags
+	}
+	if stateOwnerID.IsNull() {
+		return diags
+	}
+
+	stateResolvedOwnerID, err := resolveApiKeyOwnerID(ctx, r.client, plan.OwnerType.ValueString(), stateOwnerID.ValueString())
+	if err != nil || stateResolvedOwnerID != ownerID {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("owner_id"))
+	}
+	return diags
+}
+
+// resolveApiKeyOwnerID returns the owner ID to send to the API for the given owner_id, which is either an
+// ID or the email address of a user.
+func resolveApiKeyOwnerID(ctx context.Context, c *client.Client, ownerType string, ownerID string) (string, error) {
+	if !isEmail(ownerID) {
+		return ownerID, nil
+	}
+	if t, err := enums.ToOwnerType(ownerType); err != nil || t != identityv1.OwnerType_OWNER_TYPE_USER {
+		return "", fmt.Errorf("owner_id %q is an email address, which is only supported for owner_type %q", ownerID, "user")
+	}
+
+	user, err := findUserByEmail(ctx, c, ownerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up user %q: %w", ownerID, err)
+	}
+	if user == nil {
+		return "", fmt.Errorf("no user with email %q found", ownerID)
+	}
+	return user.GetId(), nil
+}
+
+func isEmail(s string) bool {
+	return strings.Contains(s, "@")
+}
+
+// validateApiKeyExpiry checks that a new API key expires in the future, and no later than the maximum
+// expiry Temporal Cloud allows.
+func validateApiKeyExpiry(p path.Path, expiryTime time.Time, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !expiryTime.After(now) {
+		diags.AddAttributeError(p, "Invalid API key expiry", fmt.Sprintf("The API key would expire at %s, which is not in the future.", expiryTime.UTC().Format(time.RFC3339)))
+		return diags
+	}
+	if expiryTime.Sub(now) > maxApiKeyExpiry {
+		diags.AddAttributeError(p, "Invalid API key expiry", fmt.Sprintf("The API key would expire at %s, but API keys may expire at most %d days from now.", expiryTime.UTC().Format(time.RFC3339), int(maxApiKeyExpiry.Hours()/24)))
+	}
+	return diags
+}
+
+// apiKeyExpiryTimeFromPlan returns the expiry time of an API key created at now, from expires_in if it is set
+// and from expiry_time otherwise.
+func apiKeyExpiryTimeFromPlan(plan *apiKeyResourceModel, now time.Time) (time.Time, error) {
+	if !plan.ExpiresIn.IsNull() {
+		expiresIn, err := validators.ParseDuration(plan.ExpiresIn.ValueString())
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(expiresIn).Truncate(time.Second), nil
+	}
+
+	return time.Parse(time.RFC3339, plan.ExpiryTime.ValueString())
+}
+
+// apiKeyExpiryWarning warns when an API key expires within window from now, or has already expired.
+func apiKeyExpiryWarning(displayName string, expiryTime time.Time, now time.Time, window time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch {
+	case !expiryTime.After(now):
+		diags.AddWarning("API key expired", fmt.Sprintf("The API key %q expired at %s. Replace it, for example by changing expires_in or expiry_time.", displayName, expiryTime.UTC().Format(time.RFC3339)))
+	case expiryTime.Sub(now) <= window:
+		diags.AddWarning("API key expires soon", fmt.Sprintf("The API key %q expires at %s, in %d days. Replace it, for example by changing expires_in or expiry_time.", displayName, expiryTime.UTC().Format(time.RFC3339), daysUntil(expiryTime, now)))
+	}
+	return diags
+}
+
+// daysUntil returns the number of full days from now until t, or 0 if t is not after now.
+func daysUntil(t time.Time, now time.Time) int64 {
+	if !t.After(now) {
+		return 0
+	}
+	return int64(t.Sub(now) / (24 * time.Hour))
+}
+
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan apiKeyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -161,11 +397,9 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
-	// Parse the expiry time from the plan
-	expiryTimeString := plan.ExpiryTime.ValueString()
-	expiryTime, err := time.Parse(time.RFC3339, expiryTimeString)
+	expiryTime, err := apiKeyExpiryTimeFromPlan(&plan, time.Now())
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ExpiryTime", "Could not parse ExpiryTime from plan: "+err.Error())
+		resp.Diagnostics.AddError("Invalid ExpiryTime", "Could not resolve ExpiryTime from plan: "+err.Error())
 		return
 	}
 
@@ -187,9 +421,14 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError(err.Error(), "")
 		return
 	}
+	ownerID, err := resolveApiKeyOwnerID(ctx, r.client, plan.OwnerType.ValueString(), plan.OwnerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to resolve API key owner", err.Error())
+		return
+	}
 	svcResp, err := r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
 		Spec: &identityv1.ApiKeySpec{
-			OwnerId:     plan.OwnerID.ValueString(),
+			OwnerId:     ownerID,
 			OwnerType:   ownerType,
 			DisplayName: plan.DisplayName.ValueString(),
 			Description: description,
@@ -216,12 +455,21 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	configuredOwnerID := plan.OwnerID
 	err = updateApiKeyModelFromSpec(&plan, apiKey.ApiKey)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
 		return
 	}
+	if ownerID == plan.OwnerID.ValueString() {
+		plan.OwnerID = configuredOwnerID
+	}
 	plan.Token = types.StringValue(svcResp.Token)
+	if !plan.StoreToken.ValueBool() {
+		// Hand the token over to the temporalcloud_apikey_token ephemeral resource instead of storing it.
+		apiKeyTokens.Store(svcResp.GetKeyId(), svcResp.GetToken())
+		plan.Token = types.StringNull()
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -251,10 +499,29 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	configuredOwnerID := state.OwnerID
 	if err := updateApiKeyModelFromSpec(&state, apiKey.ApiKey); err != nil {
 		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
 		return
 	}
+	if isEmail(configuredOwnerID.ValueString()) {
+		// Keep the email address as long as it still belongs to the owner of the API key.
+		user, err := findUserByEmail(ctx, r.client, configuredOwnerID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resolve API key owner", err.Error())
+			return
+		}
+		if user != nil && user.GetId() == state.OwnerID.ValueString() {
+			state.OwnerID = configuredOwnerID
+		}
+	}
+	if state.StoreToken.IsNull() {
+		// Imported API keys, or keys created before store_token existed.
+		state.StoreToken = types.BoolValue(true)
+	}
+	if !state.StoreToken.ValueBool() {
+		state.Token = types.StringNull()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -299,10 +566,15 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError(err.Error(), "")
 		return
 	}
+	ownerID, err := resolveApiKeyOwnerID(ctx, r.client, plan.OwnerType.ValueString(), plan.OwnerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to resolve API key owner", err.Error())
+		return
+	}
 	svcResp, err := r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
 		KeyId: plan.ID.ValueString(),
 		Spec: &identityv1.ApiKeySpec{
-			OwnerId:     plan.OwnerID.ValueString(),
+			OwnerId:     ownerID,
 			OwnerType:   ownerType,
 			DisplayName: plan.DisplayName.ValueString(),
 			Description: description,
@@ -330,10 +602,14 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	configuredOwnerID := plan.OwnerID
 	if err := updateApiKeyModelFromSpec(&plan, apiKey.ApiKey); err != nil {
 		resp.Diagnostics.AddError("Failed to convert apikey spec", err.Error())
 		return
 	}
+	if ownerID == plan.OwnerID.ValueString() {
+		plan.OwnerID = configuredOwnerID
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -394,6 +670,87 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	owner, displayName, ok := parseApiKeyImportID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	var ownerID string
+	if isEmail(owner) {
+		user, err := findUserByEmail(ctx, r.client, owner)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get user", err.Error())
+			return
+		}
+		if user == nil {
+			resp.Diagnostics.AddError("User not found", fmt.Sprintf("No user with email %q found.", owner))
+			return
+		}
+		ownerID = user.GetId()
+	} else {
+		serviceAccount, err := findServiceAccountByName(ctx, r.client, owner)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get service account", err.Error())
+			return
+		}
+		if serviceAccount == nil {
+			resp.Diagnostics.AddError("Service account not found", fmt.Sprintf("No service account named %q found.", owner))
+			return
+		}
+		ownerID = serviceAccount.GetId()
+	}
+
+	apiKeysReq := &cloudservicev1.GetApiKeysRequest{OwnerId: ownerID}
+	var matches []*identityv1.ApiKey
+	for {
+		apiKeysResp, err := r.client.CloudService().GetApiKeys(ctx, apiKeysReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get API keys", err.Error())
+			return
+		}
+
+		for _, apiKey := range apiKeysResp.GetApiKeys() {
+			if apiKey.GetSpec().GetDisplayName() == displayName {
+				matches = append(matches, apiKey)
+			}
+		}
+
+		if apiKeysResp.GetNextPageToken() == "" {
+			break
+		}
+
+		apiKeysReq.PageToken = apiKeysResp.GetNextPageToken()
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("API key not found", fmt.Sprintf("No API key named %q owned by %q found.", displayName, owner))
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError("Ambiguous API key", fmt.Sprintf("Found %d API keys named %q owned by %q, import the API key by its ID instead.", len(matches), displayName, owner))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].GetId())...)
+	if isEmail(owner) {
+		// Read keeps the email address as owner_id, matching configurations that reference the owner by email.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_id"), owner)...)
+	}
+}
+
+// parseApiKeyImportID splits an import ID of the form owner/display_name, where owner is the email address
+// of a user or the name of a service account. It returns false for plain API key IDs.
+func parseApiKeyImportID(id string) (owner string, displayName string, ok bool) {
+	owner, displayName, ok = strings.Cut(id, "/")
+	if !ok || owner == "" || displayName == "" {
+		return "", "", false
+	}
+	return owner, displayName, true
+}
+
 func updateApiKeyModelFromSpec(state *apiKeyResourceModel, apikey *identityv1.ApiKey) error {
 	state.ID = types.StringValue(apikey.GetId())
 	stateStr, err := enums.FromResourceState(apikey.GetState())
@@ -412,6 +769,9 @@ func updateApiKeyModelFromSpec(state *apiKeyResourceModel, apikey *identityv1.Ap
 		state.Description = types.StringValue(apikey.GetSpec().GetDescription())
 	}
 	state.ExpiryTime = types.StringValue(apikey.GetSpec().GetExpiryTime().AsTime().Format(time.RFC3339))
+	now := time.Now()
+	state.Expired = types.BoolValue(!apikey.GetSpec().GetExpiryTime().AsTime().After(now))
+	state.DaysUntilExpiry = types.Int64Value(daysUntil(apikey.GetSpec().GetExpiryTime().AsTime(), now))
 	state.Disabled = types.BoolValue(apikey.GetSpec().GetDisabled())
 
 	return nil
@@ -7,7 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -223,3 +229,245 @@ resource "temporalcloud_apikey" "test" {
 		},
 	})
 }
+
+func TestAccApiKeyWithoutStoredToken(t *testing.T) {
+	apiKeyName := createRandomApiKeyName()
+	serviceAccountName := createRandomName()
+	config := func(storeToken bool) string {
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "terraform" {
+	name = "%s"
+	account_access = "Read"
+}
+
+resource "temporalcloud_apikey" "test" {
+	display_name = "%s"
+	owner_type = "service-account"
+	owner_id = temporalcloud_service_account.terraform.id
+	expiry_time = "%s"
+	store_token = %t
+}`, serviceAccountName, apiKeyName, getExpiryTime(), storeToken)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("temporalcloud_apikey.test", "token"),
+				),
+			},
+			{
+				// Removes the token from the existing state.
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("temporalcloud_apikey.test", "token"),
+					resource.TestCheckResourceAttr("temporalcloud_apikey.test", "store_token", "false"),
+				),
+			},
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"store_token", "timeouts"},
+				ResourceName:            "temporalcloud_apikey.test",
+			},
+			{
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s", serviceAccountName, apiKeyName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"store_token", "timeouts"},
+				ResourceName:            "temporalcloud_apikey.test",
+			},
+		},
+	})
+}
+
+func TestParseApiKeyImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id              string
+		wantOwner       string
+		wantDisplayName string
+		wantOK          bool
+	}{
+		{id: "2f1b3b5e6c7d4e8f9a0b1c2d3e4f5a6b"},
+		{id: "jane@example.com/ci", wantOwner: "jane@example.com", wantDisplayName: "ci", wantOK: true},
+		{id: "worker-sa/worker/prod", wantOwner: "worker-sa", wantDisplayName: "worker/prod", wantOK: true},
+		{id: "worker-sa/"},
+		{id: "/worker"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.id, func(t *testing.T) {
+			t.Parallel()
+
+			owner, displayName, ok := parseApiKeyImportID(tc.id)
+			if owner != tc.wantOwner || displayName != tc.wantDisplayName || ok != tc.wantOK {
+				t.Errorf("parseApiKeyImportID(%q) = (%q, %q, %v), want (%q, %q, %v)", tc.id, owner, displayName, ok, tc.wantOwner, tc.wantDisplayName, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestValidateApiKeyExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		expiryTime time.Time
+		wantError  bool
+	}{
+		{name: "future expiry is valid", expiryTime: now.Add(90 * 24 * time.Hour)},
+		{name: "past expiry is invalid", expiryTime: now.Add(-time.Hour), wantError: true},
+		{name: "expiry beyond the maximum is invalid", expiryTime: now.Add(maxApiKeyExpiry + time.Hour), wantError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateApiKeyExpiry(path.Root("expires_in"), tc.expiryTime, now)
+			if diags.HasError() != tc.wantError {
+				t.Errorf("HasError() = %v, want %v: %+v", diags.HasError(), tc.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestApiKeyExpiryWarning(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	window := 30 * 24 * time.Hour
+
+	if diags := apiKeyExpiryWarning("key", now.Add(60*24*time.Hour), now, window); len(diags) != 0 {
+		t.Errorf("expected no warning outside of the window, got %+v", diags)
+	}
+	if diags := apiKeyExpiryWarning("key", now.Add(10*24*time.Hour), now, window); len(diags) != 1 || diags.HasError() {
+		t.Errorf("expected a warning within the window, got %+v", diags)
+	}
+	if diags := apiKeyExpiryWarning("key", now.Add(-time.Hour), now, window); len(diags) != 1 || diags.HasError() {
+		t.Errorf("expected a warning for an expired key, got %+v", diags)
+	}
+	if days := daysUntil(now.Add(36*time.Hour), now); days != 1 {
+		t.Errorf("daysUntil() = %d, want 1", days)
+	}
+	if days := daysUntil(now.Add(-36*time.Hour), now); days != 0 {
+		t.Errorf("daysUntil() = %d, want 0", days)
+	}
+}
+
+func TestApiKeyModifyPlanExpiresIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &apiKeyResource{}
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResponse.Schema}
+	diags := plan.Set(ctx, &apiKeyResourceModel{
+		ID:                  types.StringUnknown(),
+		State:               types.StringUnknown(),
+		OwnerType:           types.StringValue("service-account"),
+		OwnerID:             types.StringValue("service-account-id"),
+		DisplayName:         types.StringValue("key"),
+		Token:               types.StringUnknown(),
+		Description:         types.StringNull(),
+		ExpiryTime:          types.StringUnknown(),
+		ExpiresIn:           types.StringValue("90d"),
+		Disabled:            types.BoolValue(false),
+		StoreToken:          types.BoolValue(true),
+		Expired:             types.BoolUnknown(),
+		DaysUntilExpiry:     types.Int64Unknown(),
+		ExpiryWarningWindow: types.StringNull(),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"delete": types.StringType,
+		})},
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to set plan: %+v", diags)
+	}
+	state := tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil)}
+
+	// Terraform runs ModifyPlan once for the saved plan and again when applying it, the expiry time must not
+	// depend on when either of them runs, or the final plan is inconsistent with the saved one.
+	modifyPlan := func() types.String {
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ModifyPlan diagnostics: %+v", resp.Diagnostics)
+		}
+		var expiryTime types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("expiry_time"), &expiryTime)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("failed to get expiry_time: %+v", resp.Diagnostics)
+		}
+		return expiryTime
+	}
+
+	planned := modifyPlan()
+	time.Sleep(1100 * time.Millisecond)
+	applied := modifyPlan()
+	if !planned.IsUnknown() || !applied.Equal(planned) {
+		t.Errorf("expiry_time = %s on plan and %s on apply, want it to stay unknown", planned, applied)
+	}
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	expiryTime, err := apiKeyExpiryTimeFromPlan(&apiKeyResourceModel{ExpiresIn: types.StringValue("90d")}, now)
+	if err != nil || !expiryTime.Equal(now.Add(90*24*time.Hour)) {
+		t.Errorf("apiKeyExpiryTimeFromPlan() = %s, %v, want %s", expiryTime, err, now.Add(90*24*time.Hour))
+	}
+}
+
+func TestAccApiKeyExpiresIn(t *testing.T) {
+	apiKeyName := createRandomApiKeyName()
+	serviceAccountName := createRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "terraform" {
+	name = "%s"
+	account_access = "Read"
+}
+
+resource "temporalcloud_apikey" "test" {
+	display_name = "%s"
+	owner_type = "service-account"
+	owner_id = temporalcloud_service_account.terraform.id
+	expires_in = "90d"
+	expiry_warning_window = "30d"
+}`, serviceAccountName, apiKeyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("temporalcloud_apikey.test", "expiry_time"),
+					resource.TestCheckResourceAttr("temporalcloud_apikey.test", "expired", "false"),
+					resource.TestCheckResourceAttr("temporalcloud_apikey.test", "days_until_expiry", "89"),
+				),
+			},
+		},
+	})
+}
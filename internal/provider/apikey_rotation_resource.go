@@ -0,0 +1,840 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/validators"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
+)
+
+type (
+	apiKeyRotationResource struct {
+		client *client.Client
+	}
+
+	apiKeyRotationResourceModel struct {
+		ID                types.String   `tfsdk:"id"`
+		OwnerType         types.String   `tfsdk:"owner_type"`
+		OwnerID           types.String   `tfsdk:"owner_id"`
+		DisplayNamePrefix types.String   `tfsdk:"display_name_prefix"`
+		Description       types.String   `tfsdk:"description"`
+		RotationPeriod    types.String   `tfsdk:"rotation_period"`
+		GracePeriod       types.String   `tfsdk:"grace_period"`
+		KeyCount          types.Int64    `tfsdk:"key_count"`
+		CurrentKeyID      types.String   `tfsdk:"current_key_id"`
+		CurrentToken      types.String   `tfsdk:"current_token"`
+		PreviousKeyID     types.String   `tfsdk:"previous_key_id"`
+		PreviousToken     types.String   `tfsdk:"previous_token"`
+		Keys              types.List     `tfsdk:"keys"`
+		Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	apiKeyRotationKeyModel struct {
+		ID          types.String `tfsdk:"id"`
+		DisplayName types.String `tfsdk:"display_name"`
+		State       types.String `tfsdk:"state"`
+		Disabled    types.Bool   `tfsdk:"disabled"`
+		CreatedTime types.String `tfsdk:"created_time"`
+		ExpiryTime  types.String `tfsdk:"expiry_time"`
+	}
+
+	// apiKeyRotationKey is the part of a rotated API key needed to decide on the next rotation.
+	apiKeyRotationKey struct {
+		id       string
+		created  time.Time
+		disabled bool
+	}
+
+	// apiKeyRotationPlan lists the changes required to bring the rotated API keys up to date.
+	apiKeyRotationPlan struct {
+		rotate  bool
+		disable []string
+		delete  []string
+	}
+)
+
+var (
+	apiKeyRotationKeyAttrs = map[string]attr.Type{
+		"id":           types.StringType,
+		"display_name": types.StringType,
+		"state":        types.StringType,
+		"disabled":     types.BoolType,
+		"created_time": types.StringType,
+		"expiry_time":  types.StringType,
+	}
+)
+
+var (
+	_ resource.Resource               = (*apiKeyRotationResource)(nil)
+	_ resource.ResourceWithConfigure  = (*apiKeyRotationResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*apiKeyRotationResource)(nil)
+)
+
+func NewApiKeyRotationResource() resource.Resource {
+	return &apiKeyRotationResource{}
+}
+
+func (r *apiKeyRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiKeyRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey_rotation"
+}
+
+func (r *apiKeyRotationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Maintains a set of overlapping, automatically rotated Temporal Cloud API keys for one owner. A new API key is created when the newest one is older than `rotation_period`. API keys that are no longer among the newest `key_count` keys are disabled, and deleted once `grace_period` has passed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API key rotation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_type": schema.StringAttribute{
+				Description: "The type of the owner to create the API keys for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the owner to create the API keys for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name_prefix": schema.StringAttribute{
+				Description: "The prefix of the display name of the API keys. The creation time of each API key is appended to it.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description for the API keys created from now on.",
+				Optional:    true,
+			},
+			"rotation_period": schema.StringAttribute{
+				Description: "How often a new API key is created, as a Go duration string that may start with a number of days (for example `30d` or `720h`).",
+				Required:    true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"grace_period": schema.StringAttribute{
+				Description: "How long an API key is kept disabled, after it is no longer among the newest `key_count` API keys, before it is deleted, as a Go duration string that may start with a number of days (for example `1d`). Defaults to `24h`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("24h"),
+				Validators: []validator.String{
+					validators.NonNegativeDuration(),
+				},
+			},
+			"key_count": schema.Int64Attribute{
+				Description: "The number of newest API keys that are kept enabled. Defaults to 2.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"current_key_id": schema.StringAttribute{
+				Description: "The ID of the newest API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_token": schema.StringAttribute{
+				Description: "The token of the newest API key.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_key_id": schema.StringAttribute{
+				Description: "The ID of the second newest API key. Null until the first rotation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_token": schema.StringAttribute{
+				Description: "The token of the second newest API key. Null until the first rotation.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "The API keys managed by the rotation, ordered from newest to oldest.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the API key.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the API key.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The current state of the API key.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether the API key is disabled.",
+							Computed:    true,
+						},
+						"created_time": schema.StringAttribute{
+							Description: "The creation time of the API key in ISO 8601 format.",
+							Computed:    true,
+						},
+						"expiry_time": schema.StringAttribute{
+							Description: "The expiry time of the API key in ISO 8601 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *apiKeyRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationPeriod.IsUnknown() || plan.GracePeriod.IsUnknown() || plan.KeyCount.IsUnknown() {
+		return
+	}
+
+	rotationPeriod, gracePeriod, diags := getApiKeyRotationPeriodsFromModel(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the expiry of the API keys now, rather than failing midway through a rotation.
+	resp.Diagnostics.Append(validateApiKeyRotationExpiry(rotationPeriod, gracePeriod, plan.KeyCount.ValueInt64())...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []apiKeyRotationKeyModel
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotationKeys := make([]apiKeyRotationKey, 0, len(keys))
+	for _, key := range keys {
+		created, err := time.Parse(time.RFC3339, key.CreatedTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid API key creation time in state", err.Error())
+			return
+		}
+		rotationKeys = append(rotationKeys, apiKeyRotationKey{
+			id:       key.ID.ValueString(),
+			created:  created,
+			disabled: key.Disabled.ValueBool(),
+		})
+	}
+
+	rotationPlan := planApiKeyRotation(rotationKeys, time.Now(), rotationPeriod, gracePeriod, int(plan.KeyCount.ValueInt64()))
+	if !rotationPlan.rotate && len(rotationPlan.disable) == 0 && len(rotationPlan.delete) == 0 {
+		return
+	}
+
+	// A rotation is due, mark everything it changes as unknown so the update is planned.
+	if rotationPlan.rotate {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_key_id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_token"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_key_id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_token"), types.StringUnknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("keys"), types.ListUnknown(types.ObjectType{AttrTypes: apiKeyRotationKeyAttrs}))...)
+}
+
+func (r *apiKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.OwnerID.ValueString(), plan.DisplayNamePrefix.ValueString()))
+	updated, diags := r.rotate(ctx, &plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if !updated {
+		return
+	}
+
+	// The state is also set when the rotation failed after creating the API key, so its token is not lost.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *apiKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, diags := r.getRotationApiKeys(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(apiKeys) == 0 {
+		tflog.Warn(ctx, "API Key Rotation Resource has no API keys left, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tokens := map[string]types.String{
+		state.CurrentKeyID.ValueString():  state.CurrentToken,
+		state.PreviousKeyID.ValueString(): state.PreviousToken,
+	}
+	resp.Diagnostics.Append(updateApiKeyRotationModelFromApiKeys(ctx, &state, apiKeys, tokens)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *apiKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The API keys only change when a rotation was planned. Other changes, such as the description,
+	// only apply to the API keys created by later rotations.
+	if plan.Keys.IsUnknown() {
+		updated, diags := r.rotate(ctx, &plan, &state)
+		resp.Diagnostics.Append(diags...)
+		if !updated {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *apiKeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	apiKeys, diags := r.getRotationApiKeys(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, apiKey := range apiKeys {
+		resp.Diagnostics.Append(r.deleteApiKey(ctx, apiKey)...)
+	}
+}
+
+// rotate brings the API keys of the rotation up to date with the plan: it creates a new API key when the
+// newest one is older than the rotation period, disables the API keys that are no longer among the newest
+// key_count ones, and deletes them once the grace period has passed. state is nil on create.
+//
+// It reports whether the plan was updated with the API keys of the rotation. Once the API keys have been
+// fetched or a new one was created, the plan is updated even if a later step fails, so that the state keeps
+// track of the new API key and its token and the next apply can continue the rotation.
+func (r *apiKeyRotationResource) rotate(ctx context.Context, plan *apiKeyRotationResourceModel, state *apiKeyRotationResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rotationPeriod, gracePeriod, d := getApiKeyRotationPeriodsFromModel(plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var apiKeys []*identityv1.ApiKey
+	tokens := make(map[string]types.String)
+	if state != nil {
+		apiKeys, d = r.getRotationApiKeys(ctx, state)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+		tokens[state.CurrentKeyID.ValueString()] = state.CurrentToken
+		tokens[state.PreviousKeyID.ValueString()] = state.PreviousToken
+	}
+
+	now := time.Now()
+	rotationKeys := make([]apiKeyRotationKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		rotationKeys = append(rotationKeys, apiKeyRotationKeyFromApiKey(apiKey))
+	}
+	if state != nil && !plan.CurrentKeyID.IsUnknown() {
+		// No new API key was planned, so none may be created even if one became due since the plan.
+		rotationPeriod = math.MaxInt64
+	}
+	rotationPlan := planApiKeyRotation(rotationKeys, now, rotationPeriod, gracePeriod, int(plan.KeyCount.ValueInt64()))
+
+	apiKeysByID := make(map[string]*identityv1.ApiKey, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		apiKeysByID[apiKey.GetId()] = apiKey
+	}
+
+	if rotationPlan.rotate {
+		apiKey, token, d := r.createRotationApiKey(ctx, plan, now, rotationPeriod, gracePeriod)
+		diags.Append(d...)
+		if apiKey == nil {
+			// Nothing was created, so the state is left as it was.
+			return false, diags
+		}
+
+		apiKeysByID[apiKey.GetId()] = apiKey
+		tokens[apiKey.GetId()] = token
+	}
+
+	for _, id := range rotationPlan.disable {
+		if diags.HasError() {
+			break
+		}
+
+		apiKey, d := r.disableApiKey(ctx, apiKeysByID[id])
+		diags.Append(d...)
+		if apiKey != nil {
+			apiKeysByID[id] = apiKey
+		}
+	}
+
+	for _, id := range rotationPlan.delete {
+		if diags.HasError() {
+			break
+		}
+
+		d := r.deleteApiKey(ctx, apiKeysByID[id])
+		diags.Append(d...)
+		if !d.HasError() {
+			delete(apiKeysByID, id)
+		}
+	}
+
+	remaining := make([]*identityv1.ApiKey, 0, len(apiKeysByID))
+	for _, apiKey := range apiKeysByID {
+		remaining = append(remaining, apiKey)
+	}
+
+	d = updateApiKeyRotationModelFromApiKeys(ctx, plan, remaining, tokens)
+	diags.Append(d...)
+	return !d.HasError(), diags
+}
+
+// createRotationApiKey creates the next API key of the rotation. It returns nil if no API key was created.
+// If the API key was created but could not be fetched afterwards, it returns the API key as requested, so that
+// its token is kept, along with the error.
+func (r *apiKeyRotationResource) createRotationApiKey(ctx context.Context, plan *apiKeyRotationResourceModel, now time.Time, rotationPeriod, gracePeriod time.Duration) (*identityv1.ApiKey, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ownerType, err := enums.ToOwnerType(plan.OwnerType.ValueString())
+	if err != nil {
+		diags.AddError(err.Error(), "")
+		return nil, types.StringNull(), diags
+	}
+
+	expiryTime := now.Add(apiKeyRotationLifetime(rotationPeriod, gracePeriod, plan.KeyCount.ValueInt64()))
+	spec := &identityv1.ApiKeySpec{
+		OwnerId:     plan.OwnerID.ValueString(),
+		OwnerType:   ownerType,
+		DisplayName: fmt.Sprintf("%s-%s", plan.DisplayNamePrefix.ValueString(), now.UTC().Format("20060102T150405Z")),
+		Description: plan.Description.ValueString(),
+		ExpiryTime:  timestamppb.New(expiryTime),
+	}
+	svcResp, err := r.client.CloudService().CreateApiKey(ctx, &cloudservicev1.CreateApiKeyRequest{
+		Spec:             spec,
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		diags.AddError("Failed to create API key", err.Error())
+		return nil, types.StringNull(), diags
+	}
+
+	token := types.StringValue(svcResp.GetToken())
+	requested := &identityv1.ApiKey{
+		Id:          svcResp.GetKeyId(),
+		Spec:        spec,
+		State:       resourcev1.ResourceState_RESOURCE_STATE_ACTIVATING,
+		CreatedTime: timestamppb.New(now),
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		diags.AddError("Failed to create API key", err.Error())
+		return requested, token, diags
+	}
+
+	apiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: svcResp.GetKeyId(),
+	})
+	if err != nil {
+		diags.AddError("Failed to get API key after creation", err.Error())
+		return requested, token, diags
+	}
+
+	return apiKey.GetApiKey(), token, diags
+}
+
+// getRotationApiKeys fetches the API keys tracked in the state, skipping the ones that no longer exist.
+func (r *apiKeyRotationResource) getRotationApiKeys(ctx context.Context, state *apiKeyRotationResourceModel) ([]*identityv1.ApiKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var keys []apiKeyRotationKeyModel
+	diags.Append(state.Keys.ElementsAs(ctx, &keys, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiKeys := make([]*identityv1.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		apiKey, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+			KeyId: key.ID.ValueString(),
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				tflog.Warn(ctx, "API Key of rotation not found, no longer tracking it", map[string]interface{}{
+					"id": key.ID.ValueString(),
+				})
+
+				continue
+			}
+
+			diags.AddError("Failed to get API key", err.Error())
+			return nil, diags
+		}
+
+		apiKeys = append(apiKeys, apiKey.GetApiKey())
+	}
+
+	return apiKeys, diags
+}
+
+func (r *apiKeyRotationResource) disableApiKey(ctx context.Context, apiKey *identityv1.ApiKey) (*identityv1.ApiKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Copy the spec, so the API key still shows as enabled if the update fails.
+	spec := proto.Clone(apiKey.GetSpec()).(*identityv1.ApiKeySpec)
+	spec.Disabled = true
+	svcResp, err := r.client.CloudService().UpdateApiKey(ctx, &cloudservicev1.UpdateApiKeyRequest{
+		KeyId:            apiKey.GetId(),
+		Spec:             spec,
+		ResourceVersion:  apiKey.GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		diags.AddError("Failed to disable API key", err.Error())
+		return nil, diags
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		diags.AddError("Failed to disable API key", err.Error())
+		return nil, diags
+	}
+
+	updated, err := r.client.CloudService().GetApiKey(ctx, &cloudservicev1.GetApiKeyRequest{
+		KeyId: apiKey.GetId(),
+	})
+	if err != nil {
+		diags.AddError("Failed to get API key after update", err.Error())
+		return nil, diags
+	}
+
+	return updated.GetApiKey(), diags
+}
+
+func (r *apiKeyRotationResource) deleteApiKey(ctx context.Context, apiKey *identityv1.ApiKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	svcResp, err := r.client.CloudService().DeleteApiKey(ctx, &cloudservicev1.DeleteApiKeyRequest{
+		KeyId:            apiKey.GetId(),
+		ResourceVersion:  apiKey.GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Warn(ctx, "API Key of rotation not found, skipping delete", map[string]interface{}{
+				"id": apiKey.GetId(),
+			})
+
+			return diags
+		}
+
+		diags.AddError("Failed to delete API key", err.Error())
+		return diags
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		diags.AddError("Failed to delete API key", err.Error())
+	}
+
+	return diags
+}
+
+// planApiKeyRotation decides which changes bring the API keys of a rotation up to date at now.
+//
+// A new API key is due when there is none, or the newest one is older than rotationPeriod. An API key
+// retires when it drops out of the newest keyCount keys, that is when the API key keyCount places newer
+// than it was created. Retired API keys are disabled, and deleted once they have been retired for gracePeriod.
+func planApiKeyRotation(keys []apiKeyRotationKey, now time.Time, rotationPeriod, gracePeriod time.Duration, keyCount int) apiKeyRotationPlan {
+	sorted := make([]apiKeyRotationKey, len(keys))
+	copy(sorted, keys)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].created.After(sorted[j].created)
+	})
+
+	var plan apiKeyRotationPlan
+	plan.rotate = len(sorted) == 0 || now.Sub(sorted[0].created) >= rotationPeriod
+
+	createdTimes := make([]time.Time, 0, len(sorted)+1)
+	if plan.rotate {
+		createdTimes = append(createdTimes, now)
+	}
+	for _, key := range sorted {
+		createdTimes = append(createdTimes, key.created)
+	}
+
+	offset := len(createdTimes) - len(sorted)
+	for i, key := range sorted {
+		position := i + offset
+		if position < keyCount {
+			continue
+		}
+
+		retiredAt := createdTimes[position-keyCount]
+		switch {
+		case now.Sub(retiredAt) >= gracePeriod:
+			plan.delete = append(plan.delete, key.id)
+		case !key.disabled:
+			plan.disable = append(plan.disable, key.id)
+		}
+	}
+
+	return plan
+}
+
+func apiKeyRotationKeyFromApiKey(apiKey *identityv1.ApiKey) apiKeyRotationKey {
+	return apiKeyRotationKey{
+		id:       apiKey.GetId(),
+		created:  apiKey.GetCreatedTime().AsTime(),
+		disabled: apiKey.GetSpec().GetDisabled(),
+	}
+}
+
+// apiKeyRotationLifetime returns how long after its creation an API key of the rotation expires. Every API key
+// outlives its time among the newest keyCount keys plus the grace period. It saturates instead of overflowing.
+func apiKeyRotationLifetime(rotationPeriod, gracePeriod time.Duration, keyCount int64) time.Duration {
+	if keyCount > 0 && rotationPeriod > (math.MaxInt64-gracePeriod)/time.Duration(keyCount) {
+		return math.MaxInt64
+	}
+	return rotationPeriod*time.Duration(keyCount) + gracePeriod
+}
+
+// validateApiKeyRotationExpiry validates the expiry of the API keys the rotation creates.
+func validateApiKeyRotationExpiry(rotationPeriod, gracePeriod time.Duration, keyCount int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if apiKeyRotationLifetime(rotationPeriod, gracePeriod, keyCount) > maxApiKeyExpiry {
+		diags.AddAttributeError(
+			path.Root("rotation_period"),
+			"Invalid API key expiry",
+			fmt.Sprintf("API keys of the rotation expire rotation_period times key_count plus grace_period after they are created, but API keys may expire at most %d days after they are created.", int(maxApiKeyExpiry.Hours()/24)),
+		)
+	}
+	return diags
+}
+
+func getApiKeyRotationPeriodsFromModel(model *apiKeyRotationResourceModel) (time.Duration, time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rotationPeriod, err := validators.ParseDuration(model.RotationPeriod.ValueString())
+	if err != nil || rotationPeriod <= 0 {
+		diags.AddAttributeError(path.Root("rotation_period"), "Invalid rotation_period", fmt.Sprintf("rotation_period must be a positive duration such as 30d, got %q", model.RotationPeriod.ValueString()))
+	}
+
+	gracePeriod, err := validators.ParseDuration(model.GracePeriod.ValueString())
+	if err != nil || gracePeriod < 0 {
+		diags.AddAttributeError(path.Root("grace_period"), "Invalid grace_period", fmt.Sprintf("grace_period must be a non-negative duration such as 1d, got %q", model.GracePeriod.ValueString()))
+	}
+
+	return rotationPeriod, gracePeriod, diags
+}
+
+// updateApiKeyRotationModelFromApiKeys sets the keys of the model, newest first, along with the current and
+// previous API key. tokens holds the known tokens by API key ID.
+func updateApiKeyRotationModelFromApiKeys(ctx context.Context, model *apiKeyRotationResourceModel, apiKeys []*identityv1.ApiKey, tokens map[string]types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sorted := make([]*identityv1.ApiKey, len(apiKeys))
+	copy(sorted, apiKeys)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetCreatedTime().AsTime().After(sorted[j].GetCreatedTime().AsTime())
+	})
+
+	keys := make([]types.Object, 0, len(sorted))
+	for _, apiKey := range sorted {
+		state, err := enums.FromResourceState(apiKey.GetState())
+		if err != nil {
+			diags.AddError("Failed to convert API key state", err.Error())
+			return diags
+		}
+
+		key, d := types.ObjectValueFrom(ctx, apiKeyRotationKeyAttrs, &apiKeyRotationKeyModel{
+			ID:          types.StringValue(apiKey.GetId()),
+			DisplayName: types.StringValue(apiKey.GetSpec().GetDisplayName()),
+			State:       types.StringValue(state),
+			Disabled:    types.BoolValue(apiKey.GetSpec().GetDisabled()),
+			CreatedTime: types.StringValue(apiKey.GetCreatedTime().AsTime().Format(time.RFC3339)),
+			ExpiryTime:  types.StringValue(apiKey.GetSpec().GetExpiryTime().AsTime().Format(time.RFC3339)),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		keys = append(keys, key)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: apiKeyRotationKeyAttrs}, keys)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	model.Keys = list
+
+	token := func(id string) types.String {
+		if t, ok := tokens[id]; ok && !t.IsUnknown() {
+			return t
+		}
+		return types.StringNull()
+	}
+
+	model.CurrentKeyID, model.CurrentToken = types.StringNull(), types.StringNull()
+	model.PreviousKeyID, model.PreviousToken = types.StringNull(), types.StringNull()
+	if len(sorted) > 0 {
+		model.CurrentKeyID = types.StringValue(sorted[0].GetId())
+		model.CurrentToken = token(sorted[0].GetId())
+	}
+	if len(sorted) > 1 {
+		model.PreviousKeyID = types.StringValue(sorted[1].GetId())
+		model.PreviousToken = token(sorted[1].GetId())
+	}
+
+	return diags
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	operationv1 "go.temporal.io/cloud-sdk/api/operation/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestApiKeyRotationSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	NewApiKeyRotationResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestPlanApiKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	rotationPeriod := 30 * day
+	gracePeriod := 2 * day
+
+	testCases := []struct {
+		name        string
+		keys        []apiKeyRotationKey
+		keyCount    int
+		wantRotate  bool
+		wantDisable []string
+		wantDelete  []string
+	}{
+		{
+			name:       "first key is created",
+			keyCount:   2,
+			wantRotate: true,
+		},
+		{
+			name: "nothing to do while the newest key is within the rotation period",
+			keys: []apiKeyRotationKey{
+				{id: "a", created: now.Add(-10 * day)},
+				{id: "b", created: now.Add(-40 * day)},
+			},
+			keyCount: 2,
+		},
+		{
+			name: "new key disables the oldest key",
+			keys: []apiKeyRotationKey{
+				{id: "b", created: now.Add(-60 * day)},
+				{id: "a", created: now.Add(-30 * day)},
+			},
+			keyCount:    2,
+			wantRotate:  true,
+			wantDisable: []string{"b"},
+		},
+		{
+			name: "retired key is deleted after the grace period",
+			keys: []apiKeyRotationKey{
+				{id: "c", created: now.Add(-3 * day)},
+				{id: "b", created: now.Add(-33 * day)},
+				{id: "a", created: now.Add(-63 * day), disabled: true},
+			},
+			keyCount:   2,
+			wantDelete: []string{"a"},
+		},
+		{
+			name: "retired key is kept disabled during the grace period",
+			keys: []apiKeyRotationKey{
+				{id: "c", created: now.Add(-1 * day)},
+				{id: "b", created: now.Add(-31 * day)},
+				{id: "a", created: now.Add(-61 * day), disabled: true},
+			},
+			keyCount: 2,
+		},
+		{
+			name: "lowering the key count retires keys immediately",
+			keys: []apiKeyRotationKey{
+				{id: "c", created: now.Add(-1 * day)},
+				{id: "b", created: now.Add(-31 * day)},
+				{id: "a", created: now.Add(-61 * day)},
+			},
+			keyCount:    1,
+			wantDisable: []string{"b"},
+			wantDelete:  []string{"a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := planApiKeyRotation(tc.keys, now, rotationPeriod, gracePeriod, tc.keyCount)
+			if plan.rotate != tc.wantRotate {
+				t.Errorf("rotate = %v, want %v", plan.rotate, tc.wantRotate)
+			}
+			if !slices.Equal(plan.disable, tc.wantDisable) {
+				t.Errorf("disable = %v, want %v", plan.disable, tc.wantDisable)
+			}
+			if !slices.Equal(plan.delete, tc.wantDelete) {
+				t.Errorf("delete = %v, want %v", plan.delete, tc.wantDelete)
+			}
+		})
+	}
+}
+
+// fakeApiKeyRotationCloudService serves API keys from memory. Creating an API key always succeeds, while
+// awaiting its creation and disabling API keys fail if configured to.
+type fakeApiKeyRotationCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	mu          sync.Mutex
+	apiKeys     map[string]*identityv1.ApiKey
+	failAwait   bool
+	failDisable bool
+}
+
+func (f *fakeApiKeyRotationCloudService) CreateApiKey(_ context.Context, req *cloudservicev1.CreateApiKeyRequest) (*cloudservicev1.CreateApiKeyResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.apiKeys["new-key"] = &identityv1.ApiKey{
+		Id:          "new-key",
+		Spec:        req.GetSpec(),
+		State:       resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		CreatedTime: timestamppb.Now(),
+	}
+	return &cloudservicev1.CreateApiKeyResponse{
+		KeyId:          "new-key",
+		Token:          "new-token",
+		AsyncOperation: &operationv1.AsyncOperation{Id: "create"},
+	}, nil
+}
+
+func (f *fakeApiKeyRotationCloudService) GetAsyncOperation(_ context.Context, req *cloudservicev1.GetAsyncOperationRequest) (*cloudservicev1.GetAsyncOperationResponse, error) {
+	state := operationv1.AsyncOperation_STATE_FULFILLED
+	if f.failAwait && req.GetAsyncOperationId() == "create" {
+		state = operationv1.AsyncOperation_STATE_FAILED
+	}
+	return &cloudservicev1.GetAsyncOperationResponse{
+		AsyncOperation: &operationv1.AsyncOperation{Id: req.GetAsyncOperationId(), State: state, FailureReason: "operation failed"},
+	}, nil
+}
+
+func (f *fakeApiKeyRotationCloudService) GetApiKey(_ context.Context, req *cloudservicev1.GetApiKeyRequest) (*cloudservicev1.GetApiKeyResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	apiKey, ok := f.apiKeys[req.GetKeyId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "API key not found")
+	}
+	return &cloudservicev1.GetApiKeyResponse{ApiKey: apiKey}, nil
+}
+
+func (f *fakeApiKeyRotationCloudService) UpdateApiKey(_ context.Context, req *cloudservicev1.UpdateApiKeyRequest) (*cloudservicev1.UpdateApiKeyResponse, error) {
+	if f.failDisable {
+		return nil, status.Error(codes.FailedPrecondition, "API key is being updated")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.apiKeys[req.GetKeyId()].Spec = req.GetSpec()
+	return &cloudservicev1.UpdateApiKeyResponse{AsyncOperation: &operationv1.AsyncOperation{Id: "update"}}, nil
+}
+
+func newApiKeyRotationTestPlan() apiKeyRotationResourceModel {
+	return apiKeyRotationResourceModel{
+		ID:                types.StringValue("owner/rotation"),
+		OwnerType:         types.StringValue("service-account"),
+		OwnerID:           types.StringValue("owner"),
+		DisplayNamePrefix: types.StringValue("rotation"),
+		RotationPeriod:    types.StringValue("1h"),
+		GracePeriod:       types.StringValue("24h"),
+		KeyCount:          types.Int64Value(1),
+		CurrentKeyID:      types.StringUnknown(),
+		CurrentToken:      types.StringUnknown(),
+		PreviousKeyID:     types.StringUnknown(),
+		PreviousToken:     types.StringUnknown(),
+		Keys:              types.ListUnknown(types.ObjectType{AttrTypes: apiKeyRotationKeyAttrs}),
+	}
+}
+
+func TestValidateApiKeyRotationExpiry(t *testing.T) {
+	t.Parallel()
+
+	day := 24 * time.Hour
+	if diags := validateApiKeyRotationExpiry(30*day, 7*day, 2); diags.HasError() {
+		t.Errorf("unexpected error for a rotation within the maximum expiry: %+v", diags)
+	}
+	if diags := validateApiKeyRotationExpiry(400*day, 7*day, 2); !diags.HasError() {
+		t.Errorf("expected an error for a rotation beyond the maximum expiry")
+	}
+	if diags := validateApiKeyRotationExpiry(100000*day, 0, 1000); !diags.HasError() {
+		t.Errorf("expected an error for a rotation whose lifetime overflows")
+	}
+}
+
+func TestApiKeyRotationKeepsNewApiKeyOnCreateFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := &fakeApiKeyRotationCloudService{apiKeys: make(map[string]*identityv1.ApiKey), failAwait: true}
+	r := &apiKeyRotationResource{client: newTestClient(t, srv)}
+
+	plan := newApiKeyRotationTestPlan()
+	updated, diags := r.rotate(ctx, &plan, nil)
+	if !diags.HasError() {
+		t.Fatal("expected the rotation to fail")
+	}
+	if !updated {
+		t.Fatal("expected the plan to be updated with the created API key")
+	}
+	if plan.CurrentKeyID.ValueString() != "new-key" || plan.CurrentToken.ValueString() != "new-token" {
+		t.Fatalf("expected the created API key and its token to be kept, got %s and %s", plan.CurrentKeyID, plan.CurrentToken)
+	}
+}
+
+func TestApiKeyRotationKeepsNewApiKeyOnDisableFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	oldKey := &identityv1.ApiKey{
+		Id:          "old-key",
+		Spec:        &identityv1.ApiKeySpec{DisplayName: "rotation-old", ExpiryTime: timestamppb.New(time.Now().Add(time.Hour))},
+		State:       resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		CreatedTime: timestamppb.New(time.Now().Add(-2 * time.Hour)),
+	}
+	srv := &fakeApiKeyRotationCloudService{apiKeys: map[string]*identityv1.ApiKey{"old-key": oldKey}, failDisable: true}
+	r := &apiKeyRotationResource{client: newTestClient(t, srv)}
+
+	state := newApiKeyRotationTestPlan()
+	if diags := updateApiKeyRotationModelFromApiKeys(ctx, &state, []*identityv1.ApiKey{oldKey}, map[string]types.String{"old-key": types.StringValue("old-token")}); diags.HasError() {
+		t.Fatalf("failed to build state: %+v", diags)
+	}
+
+	plan := newApiKeyRotationTestPlan()
+	updated, diags := r.rotate(ctx, &plan, &state)
+	if !diags.HasError() {
+		t.Fatal("expected the rotation to fail")
+	}
+	if !updated {
+		t.Fatal("expected the plan to be updated with the created API key")
+	}
+	if plan.CurrentKeyID.ValueString() != "new-key" || plan.CurrentToken.ValueString() != "new-token" {
+		t.Fatalf("expected the created API key and its token to be kept, got %s and %s", plan.CurrentKeyID, plan.CurrentToken)
+	}
+	if plan.PreviousKeyID.ValueString() != "old-key" || plan.PreviousToken.ValueString() != "old-token" {
+		t.Fatalf("expected the old API key and its token to be kept, got %s and %s", plan.PreviousKeyID, plan.PreviousToken)
+	}
+
+	var keys []apiKeyRotationKeyModel
+	if diags := plan.Keys.ElementsAs(ctx, &keys, false); diags.HasError() {
+		t.Fatalf("failed to read keys: %+v", diags)
+	}
+	if len(keys) != 2 || keys[1].Disabled.ValueBool() {
+		t.Fatalf("expected the old API key to still show as enabled, got %+v", keys)
+	}
+
+	// The next apply continues the rotation by disabling the old API key.
+	rotationKeys := make([]apiKeyRotationKey, 0, len(keys))
+	for _, apiKey := range []*identityv1.ApiKey{srv.apiKeys["new-key"], oldKey} {
+		rotationKeys = append(rotationKeys, apiKeyRotationKeyFromApiKey(apiKey))
+	}
+	rotationPlan := planApiKeyRotation(rotationKeys, time.Now(), time.Hour, 24*time.Hour, 1)
+	if rotationPlan.rotate || !slices.Equal(rotationPlan.disable, []string{"old-key"}) {
+		t.Fatalf("expected the next rotation to only disable the old API key, got %+v", rotationPlan)
+	}
+}
+
+func TestAccApiKeyRotation(t *testing.T) {
+	prefix := createRandomApiKeyName()
+	serviceAccountName := createRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "terraform" {
+	name = "%s"
+	account_access = "Read"
+}
+
+resource "temporalcloud_apikey_rotation" "test" {
+	owner_type = "service-account"
+	owner_id = temporalcloud_service_account.terraform.id
+	display_name_prefix = "%s"
+	rotation_period = "720h"
+}`, serviceAccountName, prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("temporalcloud_apikey_rotation.test", "current_key_id"),
+					resource.TestCheckResourceAttrSet("temporalcloud_apikey_rotation.test", "current_token"),
+					resource.TestCheckNoResourceAttr("temporalcloud_apikey_rotation.test", "previous_key_id"),
+					resource.TestCheckResourceAttr("temporalcloud_apikey_rotation.test", "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApiKeyRotation_InvalidPeriods(t *testing.T) {
+	config := func(rotationPeriod, gracePeriod string) string {
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_apikey_rotation" "test" {
+	owner_type = "service-account"
+	owner_id = "unused"
+	display_name_prefix = "unused"
+	rotation_period = "%s"
+	grace_period = "%s"
+}`, rotationPeriod, gracePeriod)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("1w", "1d"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			{
+				Config:      config("30d", "-1h"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			{
+				Config:      config("400d", "7d"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid API key expiry"),
+			},
+		},
+	})
+}
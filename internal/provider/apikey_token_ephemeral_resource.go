@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+// apiKeyTokens holds the tokens of API keys created with store_token = false, keyed by API key ID, until
+// they are read once by the temporalcloud_apikey_token ephemeral resource. A read token is replaced by an
+// empty string, so a second read fails instead of returning null. The framework has no channel to hand a
+// value generated by a resource to an ephemeral resource, so this only works within the provider process of
+// the apply that creates the API key.
+var apiKeyTokens sync.Map
+
+type (
+	apiKeyTokenEphemeralResource struct {
+		client *client.Client
+	}
+
+	apiKeyTokenEphemeralResourceModel struct {
+		ID    types.String `tfsdk:"id"`
+		Token types.String `tfsdk:"token"`
+	}
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*apiKeyTokenEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*apiKeyTokenEphemeralResource)(nil)
+)
+
+func NewApiKeyTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyTokenEphemeralResource{}
+}
+
+func (r *apiKeyTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiKeyTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey_token"
+}
+
+func (r *apiKeyTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes the token of a `temporalcloud_apikey` resource with `store_token = false`. The token can only be read once, in the same apply that created the API key: reading it again in that apply fails, and it is null in every later plan or apply, as Terraform opens the ephemeral resource in those too. It should be passed to a write-only attribute (for example of a secrets manager resource) whose version is tied to the API key ID. The token cannot be recovered if it is not stored in that apply, for example because the consuming resource failed: replace the API key, for example with `terraform apply -replace`, to get a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API key.",
+				Required:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The token for the API key. Null unless the API key was created in the current apply.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *apiKeyTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Token = types.StringNull()
+	if _, ok := apiKeyTokens.Load(data.ID.ValueString()); !ok {
+		// The API key was not created in this apply, its token is expected to be stored already.
+		tflog.Debug(ctx, "API key token not available outside of the apply that created the API key", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	// The token is cleared once read, so it is not kept in memory for the rest of the apply.
+	token, _ := apiKeyTokens.Swap(data.ID.ValueString(), "")
+	if token.(string) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"API Key Token Already Read",
+			fmt.Sprintf("The token of API key %s was already read in this apply, and can only be read once. If it was not stored, replace the API key, for example with terraform apply -replace, to get a new token.", data.ID.ValueString()),
+		)
+		return
+	}
+	data.Token = types.StringValue(token.(string))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestApiKeyTokenEphemeralResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := ephemeral.SchemaRequest{}
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewApiKeyTokenEphemeralResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestApiKeyTokenEphemeralResource_TokenReadOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewApiKeyTokenEphemeralResource()
+	schemaResponse := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	keyID := "token-read-once-test-key"
+	apiKeyTokens.Store(keyID, "secret-token")
+
+	open := func(id string) (apiKeyTokenEphemeralResourceModel, diag.Diagnostics) {
+		objectType := schemaResponse.Schema.Type().TerraformType(ctx)
+		req := ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResponse.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":    tftypes.NewValue(tftypes.String, id),
+					"token": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		}
+		resp := &ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+		}
+		r.Open(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return apiKeyTokenEphemeralResourceModel{}, resp.Diagnostics
+		}
+
+		var result apiKeyTokenEphemeralResourceModel
+		if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+			t.Fatalf("Result diagnostics: %+v", diags)
+		}
+		return result, resp.Diagnostics
+	}
+
+	if result, diags := open(keyID); diags.HasError() || result.Token.ValueString() != "secret-token" {
+		t.Fatalf("expected the token on the first read, got %s: %+v", result.Token, diags)
+	}
+	if _, diags := open(keyID); !diags.HasError() {
+		t.Fatalf("expected an error on the second read")
+	}
+	// API keys created before this apply have their token stored already.
+	if result, diags := open("token-read-once-test-other-key"); diags.HasError() || !result.Token.IsNull() {
+		t.Fatalf("expected a null token for an API key not created in this apply, got %s: %+v", result.Token, diags)
+	}
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+)
+
+type (
+	apiKeysDataSource struct {
+		client *client.Client
+	}
+
+	apiKeysDataModel struct {
+		ID            types.String      `tfsdk:"id"`
+		OwnerID       types.String      `tfsdk:"owner_id"`
+		OwnerType     types.String      `tfsdk:"owner_type"`
+		State         types.String      `tfsdk:"state"`
+		Disabled      types.Bool        `tfsdk:"disabled"`
+		ExpiresBefore types.String      `tfsdk:"expires_before"`
+		ApiKeys       []apiKeyDataModel `tfsdk:"apikeys"`
+	}
+
+	apiKeyDataModel struct {
+		ID               types.String `tfsdk:"id"`
+		DisplayName      types.String `tfsdk:"display_name"`
+		Description      types.String `tfsdk:"description"`
+		OwnerID          types.String `tfsdk:"owner_id"`
+		OwnerType        types.String `tfsdk:"owner_type"`
+		State            types.String `tfsdk:"state"`
+		Disabled         types.Bool   `tfsdk:"disabled"`
+		ExpiryTime       types.String `tfsdk:"expiry_time"`
+		CreatedTime      types.String `tfsdk:"created_time"`
+		LastModifiedTime types.String `tfsdk:"last_modified_time"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*apiKeysDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*apiKeysDataSource)(nil)
+)
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+func (d *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikeys"
+}
+
+func (d *apiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about all API keys of the account, optionally filtered. Tokens are never returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API keys data source.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "If set, only API keys owned by this user or service account ID are returned.",
+				Optional:    true,
+			},
+			"owner_type": schema.StringAttribute{
+				Description: "If set, only API keys with this owner type are returned. One of user or service-account.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "If set, only API keys in this state are returned, such as active or expired.",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "If set, only API keys that are (or are not) disabled are returned.",
+				Optional:    true,
+			},
+			"expires_before": schema.StringAttribute{
+				Description: "If set, only API keys expiring before this time are returned, formatted as RFC3339.",
+				Optional:    true,
+			},
+			"apikeys": schema.ListNestedAttribute{
+				Description: "The list of API keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the API key.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the API key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the API key.",
+							Computed:    true,
+						},
+						"owner_id": schema.StringAttribute{
+							Description: "The ID of the owner of the API key.",
+							Computed:    true,
+						},
+						"owner_type": schema.StringAttribute{
+							Description: "The type of the owner of the API key.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The current state of the API key.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether the API key is disabled.",
+							Computed:    true,
+						},
+						"expiry_time": schema.StringAttribute{
+							Description: "The expiry time of the API key in ISO 8601 format.",
+							Computed:    true,
+						},
+						"created_time": schema.StringAttribute{
+							Description: "The creation time of the API key in ISO 8601 format.",
+							Computed:    true,
+						},
+						"last_modified_time": schema.StringAttribute{
+							Description: "The last modified time of the API key in ISO 8601 format. Null if the API key was never modified.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state apiKeysDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeysReq := &cloudservicev1.GetApiKeysRequest{
+		OwnerId: state.OwnerID.ValueString(),
+	}
+	if !state.OwnerType.IsNull() {
+		ownerType, err := enums.ToOwnerType(state.OwnerType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("owner_type"), "Invalid owner_type", err.Error())
+			return
+		}
+		apiKeysReq.OwnerType = ownerType
+	}
+
+	var expiresBefore time.Time
+	if !state.ExpiresBefore.IsNull() {
+		var err error
+		expiresBefore, err = time.Parse(time.RFC3339, state.ExpiresBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_before"), "Invalid expires_before", fmt.Sprintf("expires_before must be formatted as RFC3339: %s", err.Error()))
+			return
+		}
+	}
+
+	var apiKeys []*identityv1.ApiKey
+	for {
+		r, err := d.client.CloudService().GetApiKeys(ctx, apiKeysReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch API keys", err.Error())
+			return
+		}
+
+		apiKeys = append(apiKeys, r.GetApiKeys()...)
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		apiKeysReq.PageToken = r.GetNextPageToken()
+	}
+
+	state.ApiKeys = make([]apiKeyDataModel, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		model, err := apiKeyToApiKeyDataModel(apiKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to convert API key", err.Error())
+			return
+		}
+
+		if !state.State.IsNull() && model.State.ValueString() != state.State.ValueString() {
+			continue
+		}
+		if !state.Disabled.IsNull() && model.Disabled.ValueBool() != state.Disabled.ValueBool() {
+			continue
+		}
+		if !state.ExpiresBefore.IsNull() && !apiKey.GetSpec().GetExpiryTime().AsTime().Before(expiresBefore) {
+			continue
+		}
+
+		state.ApiKeys = append(state.ApiKeys, *model)
+	}
+
+	accResp, err := d.client.CloudService().GetAccount(ctx, &cloudservicev1.GetAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get account information.", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("account-%s-apikeys", accResp.GetAccount().GetId()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func apiKeyToApiKeyDataModel(apiKey *identityv1.ApiKey) (*apiKeyDataModel, error) {
+	stateStr, err := enums.FromResourceState(apiKey.GetState())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerType, err := enums.FromOwnerType(apiKey.GetSpec().GetOwnerType())
+	if err != nil {
+		return nil, err
+	}
+
+	model := &apiKeyDataModel{
+		ID:               types.StringValue(apiKey.GetId()),
+		DisplayName:      types.StringValue(apiKey.GetSpec().GetDisplayName()),
+		Description:      types.StringValue(apiKey.GetSpec().GetDescription()),
+		OwnerID:          types.StringValue(apiKey.GetSpec().GetOwnerId()),
+		OwnerType:        types.StringValue(ownerType),
+		State:            types.StringValue(stateStr),
+		Disabled:         types.BoolValue(apiKey.GetSpec().GetDisabled()),
+		ExpiryTime:       types.StringValue(apiKey.GetSpec().GetExpiryTime().AsTime().Format(time.RFC3339)),
+		CreatedTime:      types.StringValue(apiKey.GetCreatedTime().AsTime().Format(time.RFC3339)),
+		LastModifiedTime: types.StringNull(),
+	}
+	if apiKey.GetLastModifiedTime() != nil {
+		model.LastModifiedTime = types.StringValue(apiKey.GetLastModifiedTime().AsTime().Format(time.RFC3339))
+	}
+
+	return model, nil
+}
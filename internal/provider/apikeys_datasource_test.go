@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestApiKeysDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewApiKeysDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAccDataSource_ApiKeys(t *testing.T) {
+	apiKeyName := createRandomApiKeyName()
+	serviceAccountName := createRandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "terraform" {
+  name           = "%s"
+  account_access = "Read"
+}
+
+resource "temporalcloud_apikey" "test" {
+  display_name = "%s"
+  owner_type   = "service-account"
+  owner_id     = temporalcloud_service_account.terraform.id
+  expires_in   = "30d"
+}
+
+data "temporalcloud_apikeys" "test" {
+  owner_id   = temporalcloud_apikey.test.owner_id
+  owner_type = "service-account"
+  disabled   = false
+}
+`, serviceAccountName, apiKeyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_apikeys.test", "apikeys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.temporalcloud_apikeys.test", "apikeys.0.id", "temporalcloud_apikey.test", "id"),
+					resource.TestCheckResourceAttr("data.temporalcloud_apikeys.test", "apikeys.0.display_name", apiKeyName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+type (
+	customRoleDataSource struct {
+		client *client.Client
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*customRoleDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*customRoleDataSource)(nil)
+)
+
+func NewCustomRoleDataSource() datasource.DataSource {
+	return &customRoleDataSource{}
+}
+
+func (d *customRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+func (d *customRoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *customRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about a custom role, looked up by ID or name.",
+		Attributes:  customRoleSchema(true),
+	}
+}
+
+func (d *customRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var input customRoleDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var customRole *identityv1.CustomRole
+	if !input.ID.IsNull() {
+		r, err := d.client.CloudService().GetCustomRole(ctx, &cloudservicev1.GetCustomRoleRequest{
+			RoleId: input.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch custom role", err.Error())
+			return
+		}
+		customRole = r.GetCustomRole()
+	} else {
+		var err error
+		customRole, err = findCustomRoleByName(ctx, d.client, input.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch custom role", err.Error())
+			return
+		}
+		if customRole == nil {
+			resp.Diagnostics.AddError("Custom role not found", fmt.Sprintf("No custom role named %q found.", input.Name.ValueString()))
+			return
+		}
+	}
+
+	model, diags := customRoleToCustomRoleDataModel(ctx, customRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findCustomRoleByName returns the custom role with the given name, or nil if there is no such custom role.
+// It fails if the name is ambiguous.
+func findCustomRoleByName(ctx context.Context, c *client.Client, name string) (*identityv1.CustomRole, error) {
+	var found *identityv1.CustomRole
+	req := &cloudservicev1.GetCustomRolesRequest{}
+	for {
+		r, err := c.CloudService().GetCustomRoles(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, customRole := range r.GetCustomRoles() {
+			if customRole.GetSpec().GetName() != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("found more than one custom role named %q", name)
+			}
+			found = customRole
+		}
+
+		if r.GetNextPageToken() == "" {
+			return found, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+type (
+	customRoleDataModel struct {
+		ID               types.String `tfsdk:"id"`
+		Name             types.String `tfsdk:"name"`
+		Description      types.String `tfsdk:"description"`
+		State            types.String `tfsdk:"state"`
+		Permissions      types.List   `tfsdk:"permissions"`
+		CreatedTime      types.String `tfsdk:"created_time"`
+		LastModifiedTime types.String `tfsdk:"last_modified_time"`
+	}
+)
+
+// customRoleSchema returns the attributes of a custom role data source. With lookup set, the custom role is
+// looked up by either its ID or its name, otherwise all attributes are computed.
+func customRoleSchema(lookup bool) map[string]schema.Attribute {
+	idAttribute := schema.StringAttribute{
+		Description: "The unique identifier of the custom role.",
+		Computed:    true,
+	}
+	nameAttribute := schema.StringAttribute{
+		Description: "The name of the custom role.",
+		Computed:    true,
+	}
+	if lookup {
+		idAttribute.Description = "The unique identifier of the custom role. Exactly one of id or name must be set."
+		idAttribute.Optional = true
+		idAttribute.Validators = []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		}
+		nameAttribute.Description = "The name of the custom role. Exactly one of id or name must be set."
+		nameAttribute.Optional = true
+	}
+
+	return map[string]schema.Attribute{
+		"id":   idAttribute,
+		"name": nameAttribute,
+		"description": schema.StringAttribute{
+			Description: "The description of the custom role.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "The current state of the custom role.",
+			Computed:    true,
+		},
+		"permissions": schema.ListNestedAttribute{
+			Description: "The permissions assigned to the custom role.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"actions": schema.SetAttribute{
+						Description: "The actions allowed by this permission.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"resources": schema.SingleNestedAttribute{
+						Description: "The resources this permission applies to.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"resource_type": schema.StringAttribute{
+								Description: "The resource type this permission applies to.",
+								Computed:    true,
+							},
+							"resource_ids": schema.SetAttribute{
+								Description: "The resource IDs this permission applies to.",
+								Computed:    true,
+								ElementType: types.StringType,
+							},
+							"allow_all": schema.BoolAttribute{
+								Description: "Whether this permission applies to all resources of the given type.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"created_time": schema.StringAttribute{
+			Description: "The creation time of the custom role in ISO 8601 format.",
+			Computed:    true,
+		},
+		"last_modified_time": schema.StringAttribute{
+			Description: "The last modified time of the custom role in ISO 8601 format. Null if the custom role was never modified.",
+			Computed:    true,
+		},
+	}
+}
+
+func customRoleToCustomRoleDataModel(ctx context.Context, customRole *identityv1.CustomRole) (*customRoleDataModel, diag.Diagnostics) {
+	var resourceModel customRoleResourceModel
+	diags := updateCustomRoleModelFromSpec(ctx, &resourceModel, customRole)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	model := &customRoleDataModel{
+		ID:               resourceModel.ID,
+		Name:             resourceModel.Name,
+		Description:      resourceModel.Description,
+		State:            resourceModel.State,
+		Permissions:      resourceModel.Permissions,
+		CreatedTime:      types.StringValue(customRole.GetCreatedTime().AsTime().Format(time.RFC3339)),
+		LastModifiedTime: types.StringNull(),
+	}
+	if customRole.GetLastModifiedTime() != nil {
+		model.LastModifiedTime = types.StringValue(customRole.GetLastModifiedTime().AsTime().Format(time.RFC3339))
+	}
+
+	return model, diags
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCustomRoleDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewCustomRoleDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestCustomRoleToCustomRoleDataModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	createdTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	model, diags := customRoleToCustomRoleDataModel(ctx, &identityv1.CustomRole{
+		Id:          "role-id",
+		State:       resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		CreatedTime: timestamppb.New(createdTime),
+		Spec: &identityv1.CustomRoleSpec{
+			Name: "role-name",
+			Permissions: []*identityv1.CustomRoleSpec_Permission{
+				{
+					Actions: []string{"cloud.account.get"},
+					Resources: &identityv1.CustomRoleSpec_Resources{
+						ResourceType: "account",
+						AllowAll:     true,
+					},
+				},
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("customRoleToCustomRoleDataModel diagnostics: %+v", diags)
+	}
+
+	if model.ID.ValueString() != "role-id" || model.Name.ValueString() != "role-name" {
+		t.Errorf("unexpected id or name: %q, %q", model.ID.ValueString(), model.Name.ValueString())
+	}
+	if len(model.Permissions.Elements()) != 1 {
+		t.Errorf("expected 1 permission, got %d", len(model.Permissions.Elements()))
+	}
+	if model.CreatedTime.ValueString() != "2025-06-01T00:00:00Z" {
+		t.Errorf("unexpected created_time %q", model.CreatedTime.ValueString())
+	}
+	if !model.LastModifiedTime.IsNull() {
+		t.Errorf("expected null last_modified_time, got %q", model.LastModifiedTime.ValueString())
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -31,6 +32,11 @@ import (
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 )
 
+// customRoleResourceTypeNamespace is the resource type of custom role permissions on namespaces. The cloud
+// SDK has no constant for it, as resource types are plain strings. TestAccCustomRole_NamespacePermission
+// checks that the API reads it back for a permission on a namespace.
+const customRoleResourceTypeNamespace = "namespace"
+
 type (
 	customRoleResource struct {
 		client *client.Client
@@ -55,6 +61,13 @@ type (
 		ResourceIDs  types.Set    `tfsdk:"resource_ids"`
 		AllowAll     types.Bool   `tfsdk:"allow_all"`
 	}
+
+	// customRoleResourceRef is a single resource ID referenced by a custom role permission.
+	customRoleResourceRef struct {
+		Path         path.Path
+		ResourceType string
+		ResourceID   string
+	}
 )
 
 var (
@@ -62,6 +75,7 @@ var (
 	_ resource.ResourceWithConfigure      = (*customRoleResource)(nil)
 	_ resource.ResourceWithImportState    = (*customRoleResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*customRoleResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*customRoleResource)(nil)
 
 	customRoleResourcesAttrs = map[string]attr.Type{
 		"resource_type": types.StringType,
@@ -147,11 +161,11 @@ func (r *customRoleResource) Schema(ctx context.Context, _ resource.SchemaReques
 							Required:    true,
 							Attributes: map[string]schema.Attribute{
 								"resource_type": schema.StringAttribute{
-									Description: "The resource type this permission applies to.",
+									Description: "The resource type this permission applies to, such as `account` or `namespace`.",
 									Required:    true,
 								},
 								"resource_ids": schema.SetAttribute{
-									Description: "The resource IDs this permission applies to. If empty, allow_all must be true.",
+									Description: "The resource IDs this permission applies to. If empty, allow_all must be true. For the namespace resource type, the namespaces are checked to exist when planning.",
 									Required:    true,
 									ElementType: types.StringType,
 								},
@@ -190,6 +204,145 @@ func (r *customRoleResource) ValidateConfig(ctx context.Context, req resource.Va
 	resp.Diagnostics.Append(validateCustomRolePermissions(ctx, config.Permissions)...)
 }
 
+func (r *customRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan customRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuredRefs, d := getCustomRoleResourceRefs(ctx, plan.Permissions)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || len(configuredRefs) == 0 {
+		return
+	}
+
+	var stateRefs []customRoleResourceRef
+	if !req.State.Raw.IsNull() {
+		var state customRoleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateRefs, d = getCustomRoleResourceRefs(ctx, state.Permissions)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	getNamespaceFn := func(ctx context.Context, namespaceReq *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error) {
+		return r.client.CloudService().GetNamespace(ctx, namespaceReq)
+	}
+	resp.Diagnostics.Append(validateCustomRoleResourceIDsWithConfig(ctx, stateRefs, configuredRefs, getNamespaceFn)...)
+}
+
+// validateCustomRoleResourceIDsWithConfig checks that the namespaces referenced by configuredRefs exist by
+// calling getNamespaceFn.
+//
+// Resource IDs that are already referenced in stateRefs are not validated again, so that a deleted
+// namespace does not block Terraform operations on the custom role. Failures to fetch a namespace other
+// than it not existing only produce a warning.
+func validateCustomRoleResourceIDsWithConfig(
+	ctx context.Context,
+	stateRefs []customRoleResourceRef,
+	configuredRefs []customRoleResourceRef,
+	getNamespaceFn func(context.Context, *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	validated := make(map[string]bool, len(stateRefs))
+	for _, ref := range stateRefs {
+		validated[ref.ResourceType+"/"+ref.ResourceID] = true
+	}
+
+	for _, ref := range configuredRefs {
+		if !strings.EqualFold(ref.ResourceType, customRoleResourceTypeNamespace) || validated[ref.ResourceType+"/"+ref.ResourceID] {
+			continue
+		}
+		validated[ref.ResourceType+"/"+ref.ResourceID] = true
+
+		_, err := getNamespaceFn(ctx, &cloudservicev1.GetNamespaceRequest{Namespace: ref.ResourceID})
+		if err == nil {
+			continue
+		}
+		if status.Code(err) == codes.NotFound {
+			diags.AddAttributeError(
+				ref.Path,
+				"Invalid Resource ID",
+				fmt.Sprintf("Namespace %q does not exist. Use the namespace ID, for example the id attribute of a temporalcloud_namespace resource.", ref.ResourceID),
+			)
+			continue
+		}
+		diags.AddWarning(
+			"Unable to Validate Resource IDs",
+			fmt.Sprintf("Failed to fetch namespace %q from Temporal Cloud API: %s. Resource ID validation will be skipped.", ref.ResourceID, err.Error()),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// getCustomRoleResourceRefs returns the known resource IDs referenced by permissions.
+func getCustomRoleResourceRefs(ctx context.Context, permissions types.List) ([]customRoleResourceRef, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if permissions.IsNull() || permissions.IsUnknown() {
+		return nil, diags
+	}
+
+	permissionObjects := make([]types.Object, 0, len(permissions.Elements()))
+	diags.Append(permissions.ElementsAs(ctx, &permissionObjects, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var refs []customRoleResourceRef
+	for i, permission := range permissionObjects {
+		if permission.IsNull() || permission.IsUnknown() {
+			continue
+		}
+
+		var permissionModel customRolePermissionModel
+		diags.Append(permission.As(ctx, &permissionModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if permissionModel.Resources.IsNull() || permissionModel.Resources.IsUnknown() {
+			continue
+		}
+
+		var resourcesModel customRoleResourcesModel
+		diags.Append(permissionModel.Resources.As(ctx, &resourcesModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if resourcesModel.ResourceType.IsUnknown() || resourcesModel.ResourceIDs.IsNull() || resourcesModel.ResourceIDs.IsUnknown() {
+			continue
+		}
+
+		resourceIDsPath := path.Root("permissions").AtListIndex(i).AtName("resources").AtName("resource_ids")
+		for _, element := range resourcesModel.ResourceIDs.Elements() {
+			resourceID, ok := element.(types.String)
+			if !ok || resourceID.IsNull() || resourceID.IsUnknown() {
+				continue
+			}
+			refs = append(refs, customRoleResourceRef{
+				Path:         resourceIDsPath.AtSetValue(resourceID),
+				ResourceType: resourcesModel.ResourceType.ValueString(),
+				ResourceID:   resourceID.ValueString(),
+			})
+		}
+	}
+
+	return refs, diags
+}
+
 func (r *customRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan customRoleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
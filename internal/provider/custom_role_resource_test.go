@@ -2,14 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
 )
@@ -180,3 +189,148 @@ func TestValidateCustomRolePermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCustomRoleResourceIDsWithConfig(t *testing.T) {
+	t.Parallel()
+
+	namespaceRef := func(id string) customRoleResourceRef {
+		return customRoleResourceRef{
+			Path:         path.Root("permissions").AtListIndex(0).AtName("resources").AtName("resource_ids").AtSetValue(types.StringValue(id)),
+			ResourceType: customRoleResourceTypeNamespace,
+			ResourceID:   id,
+		}
+	}
+	getNamespaceFn := func(_ context.Context, req *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error) {
+		if req.GetNamespace() == "ns.acct" {
+			return &cloudservicev1.GetNamespaceResponse{}, nil
+		}
+		return nil, status.Error(codes.NotFound, "namespace not found")
+	}
+
+	testCases := []struct {
+		name           string
+		stateRefs      []customRoleResourceRef
+		configuredRefs []customRoleResourceRef
+		getNamespaceFn func(context.Context, *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error)
+		wantAPICall    bool
+		wantError      bool
+		wantWarning    bool
+	}{
+		{
+			name:           "existing namespace passes validation",
+			configuredRefs: []customRoleResourceRef{namespaceRef("ns.acct")},
+			getNamespaceFn: getNamespaceFn,
+			wantAPICall:    true,
+		},
+		{
+			name:           "missing namespace produces error",
+			configuredRefs: []customRoleResourceRef{namespaceRef("missing.acct")},
+			getNamespaceFn: getNamespaceFn,
+			wantAPICall:    true,
+			wantError:      true,
+		},
+		{
+			name:           "skip validation of resource IDs already in state",
+			stateRefs:      []customRoleResourceRef{namespaceRef("missing.acct")},
+			configuredRefs: []customRoleResourceRef{namespaceRef("missing.acct")},
+			getNamespaceFn: getNamespaceFn,
+		},
+		{
+			name: "resource type is case-insensitive",
+			configuredRefs: []customRoleResourceRef{{
+				Path:         path.Root("permissions").AtListIndex(0).AtName("resources").AtName("resource_ids"),
+				ResourceType: "Namespace",
+				ResourceID:   "missing.acct",
+			}},
+			getNamespaceFn: getNamespaceFn,
+			wantAPICall:    true,
+			wantError:      true,
+		},
+		{
+			name: "skip validation of other resource types",
+			configuredRefs: []customRoleResourceRef{{
+				Path:         path.Root("permissions").AtListIndex(0).AtName("resources").AtName("resource_ids"),
+				ResourceType: "account",
+				ResourceID:   "acct",
+			}},
+			getNamespaceFn: getNamespaceFn,
+		},
+		{
+			name:           "API error produces warning, not error",
+			configuredRefs: []customRoleResourceRef{namespaceRef("ns.acct")},
+			getNamespaceFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error) {
+				return nil, errors.New("connection refused")
+			},
+			wantAPICall: true,
+			wantWarning: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			wrappedFn := func(ctx context.Context, req *cloudservicev1.GetNamespaceRequest) (*cloudservicev1.GetNamespaceResponse, error) {
+				called = true
+				return tc.getNamespaceFn(ctx, req)
+			}
+
+			diags := validateCustomRoleResourceIDsWithConfig(context.Background(), tc.stateRefs, tc.configuredRefs, wrappedFn)
+
+			if called != tc.wantAPICall {
+				t.Errorf("API called = %v, want %v", called, tc.wantAPICall)
+			}
+			if tc.wantError != diags.HasError() {
+				t.Errorf("HasError() = %v, want %v: %+v", diags.HasError(), tc.wantError, diags)
+			}
+			if tc.wantWarning && len(diags) == 0 {
+				t.Error("expected warning diagnostic, got none")
+			}
+		})
+	}
+}
+
+func TestAccCustomRole_NamespacePermission(t *testing.T) {
+	namespaceName := fmt.Sprintf("tf-custom-role-%s", randomString(8))
+	roleName := createRandomName()
+	config := func(resourceIDs string) string {
+		return testAccNamespaceConfig(namespaceName) + fmt.Sprintf(`
+resource "temporalcloud_custom_role" "test" {
+  name        = "%s"
+  description = "Custom role with a namespace permission."
+
+  permissions = [
+    {
+      actions = ["cloud.namespace.get"]
+      resources = {
+        resource_type = "%s"
+        resource_ids  = %s
+      }
+    }
+  ]
+}
+`, roleName, customRoleResourceTypeNamespace, resourceIDs)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The resource type is read back from the API, which fails the check if the API uses another
+				// resource type for namespaces, and with it the validation of namespace resource IDs.
+				Config: config("[temporalcloud_namespace.test.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_custom_role.test", "permissions.0.resources.resource_type", customRoleResourceTypeNamespace),
+					resource.TestCheckTypeSetElemAttrPair("temporalcloud_custom_role.test", "permissions.0.resources.resource_ids.*", "temporalcloud_namespace.test", "id"),
+				),
+			},
+			{
+				Config:      config(`["missing-namespace.acct"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Resource ID"),
+			},
+		},
+	})
+}
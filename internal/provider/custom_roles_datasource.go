@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+type (
+	customRolesDataSource struct {
+		client *client.Client
+	}
+
+	customRolesDataModel struct {
+		ID          types.String          `tfsdk:"id"`
+		CustomRoles []customRoleDataModel `tfsdk:"custom_roles"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*customRolesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*customRolesDataSource)(nil)
+)
+
+func NewCustomRolesDataSource() datasource.DataSource {
+	return &customRolesDataSource{}
+}
+
+func (d *customRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_roles"
+}
+
+func (d *customRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *customRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about all custom roles of the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the custom roles data source.",
+				Computed:    true,
+			},
+			"custom_roles": schema.ListNestedAttribute{
+				Description: "The list of custom roles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: customRoleSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *customRolesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state customRolesDataModel
+
+	var customRoles []*identityv1.CustomRole
+	pageToken := ""
+	for {
+		r, err := d.client.CloudService().GetCustomRoles(ctx, &cloudservicev1.GetCustomRolesRequest{PageToken: pageToken})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch custom roles", err.Error())
+			return
+		}
+
+		customRoles = append(customRoles, r.GetCustomRoles()...)
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		pageToken = r.GetNextPageToken()
+	}
+
+	state.CustomRoles = make([]customRoleDataModel, 0, len(customRoles))
+	for _, customRole := range customRoles {
+		model, diags := customRoleToCustomRoleDataModel(ctx, customRole)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.CustomRoles = append(state.CustomRoles, *model)
+	}
+
+	accResp, err := d.client.CloudService().GetAccount(ctx, &cloudservicev1.GetAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get account information.", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("account-%s-custom-roles", accResp.GetAccount().GetId()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
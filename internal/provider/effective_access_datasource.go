@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+)
+
+const (
+	principalTypeUser           = "user"
+	principalTypeServiceAccount = "service-account"
+	principalTypeGroup          = "group"
+
+	effectiveAccessSourceDirect                = "direct"
+	effectiveAccessSourceNamespaceScopedAccess = "namespace_scoped_access"
+)
+
+// namespaceAccessPermissionRanks orders the namespace permissions from least to most privileged.
+var namespaceAccessPermissionRanks = map[identityv1.NamespaceAccess_Permission]int{
+	identityv1.NamespaceAccess_PERMISSION_UNSPECIFIED: 0,
+	identityv1.NamespaceAccess_PERMISSION_READ:        1,
+	identityv1.NamespaceAccess_PERMISSION_WRITE:       2,
+	identityv1.NamespaceAccess_PERMISSION_ADMIN:       3,
+}
+
+type (
+	effectiveAccessDataSource struct {
+		client *client.Client
+	}
+
+	effectiveAccessDataModel struct {
+		ID                     types.String                        `tfsdk:"id"`
+		PrincipalID            types.String                        `tfsdk:"principal_id"`
+		PrincipalType          types.String                        `tfsdk:"principal_type"`
+		AccountAccesses        []effectiveAccountAccessDataModel   `tfsdk:"account_accesses"`
+		ImplicitNamespaceAdmin types.Bool                          `tfsdk:"implicit_namespace_admin"`
+		CustomRoles            []effectiveCustomRoleDataModel      `tfsdk:"custom_roles"`
+		NamespaceAccesses      []effectiveNamespaceAccessDataModel `tfsdk:"namespace_accesses"`
+		Groups                 []effectiveAccessGroupDataModel     `tfsdk:"groups"`
+	}
+
+	effectiveAccountAccessDataModel struct {
+		Role   types.String `tfsdk:"role"`
+		Source types.String `tfsdk:"source"`
+	}
+
+	effectiveCustomRoleDataModel struct {
+		ID     types.String `tfsdk:"id"`
+		Name   types.String `tfsdk:"name"`
+		Source types.String `tfsdk:"source"`
+	}
+
+	effectiveNamespaceAccessDataModel struct {
+		NamespaceID types.String `tfsdk:"namespace_id"`
+		Permission  types.String `tfsdk:"permission"`
+		Source      types.String `tfsdk:"source"`
+	}
+
+	effectiveAccessGroupDataModel struct {
+		ID          types.String `tfsdk:"id"`
+		DisplayName types.String `tfsdk:"display_name"`
+	}
+
+	// accessGrant is an access spec granted to a principal, either directly or through a group.
+	accessGrant struct {
+		Source string
+		Access *identityv1.Access
+	}
+
+	effectiveAccess struct {
+		// AccountRoles are the account roles granted to the principal with their source, in grant order.
+		// Account roles are not ranked, as some of them, such as financeadmin and developer, are orthogonal.
+		AccountRoles []effectiveAccountRoleGrant
+		// CustomRoles are the custom role IDs with the source that first granted them, in grant order.
+		CustomRoles []effectiveGrant
+		// NamespaceAccesses are the most privileged permission per namespace, sorted by namespace.
+		NamespaceAccesses []effectiveNamespaceGrant
+	}
+
+	effectiveAccountRoleGrant struct {
+		Role   identityv1.AccountAccess_Role
+		Source string
+	}
+
+	effectiveGrant struct {
+		ID     string
+		Source string
+	}
+
+	effectiveNamespaceGrant struct {
+		Namespace  string
+		Permission identityv1.NamespaceAccess_Permission
+		Source     string
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*effectiveAccessDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*effectiveAccessDataSource)(nil)
+)
+
+func NewEffectiveAccessDataSource() datasource.DataSource {
+	return &effectiveAccessDataSource{}
+}
+
+func (d *effectiveAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_access"
+}
+
+func (d *effectiveAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *effectiveAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the effective access of a user, service account or group, combining its own access with the access of the groups a user is a member of. Each grant reports its source: `direct` for the principal's own access, `group:<group ID>` for access inherited from a group, or `namespace_scoped_access` for namespace-scoped service accounts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the effective access data source.",
+				Computed:    true,
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The ID of the user, service account or group.",
+				Required:    true,
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The type of the principal. One of user, service-account or group.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(principalTypeUser, principalTypeServiceAccount, principalTypeGroup),
+				},
+			},
+			"account_accesses": schema.ListNestedAttribute{
+				Description: "Every account role granted to the principal, with its source, in the order of the principal's own access followed by its groups. Roles are not combined into a single role, as some of them, such as financeadmin and developer, grant different permissions. Empty if the principal has no account role.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "The account role. One of owner, admin, developer, read, financeadmin or metricsread.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "The source of the account role grant.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"implicit_namespace_admin": schema.BoolAttribute{
+				Description: "Whether the principal implicitly has admin access to all namespaces through an account role of owner or admin.",
+				Computed:    true,
+			},
+			"custom_roles": schema.ListNestedAttribute{
+				Description: "The custom roles granted to the principal.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the custom role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the custom role.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "The source of the custom role grant.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"namespace_accesses": schema.ListNestedAttribute{
+				Description: "The most privileged permission explicitly granted to the principal per namespace, sorted by namespace. Namespaces accessible only through implicit_namespace_admin are not listed.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace_id": schema.StringAttribute{
+							Description: "The namespace the permission applies to.",
+							Computed:    true,
+						},
+						"permission": schema.StringAttribute{
+							Description: "The permission on the namespace. One of admin, write or read.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "The source of the namespace permission grant.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The groups a user is a member of. Empty for service accounts and groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the group.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *effectiveAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state effectiveAccessDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principalID := state.PrincipalID.ValueString()
+	var grants []accessGrant
+	state.Groups = []effectiveAccessGroupDataModel{}
+	switch state.PrincipalType.ValueString() {
+	case principalTypeUser:
+		userResp, err := d.client.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{UserId: principalID})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get user", err.Error())
+			return
+		}
+		grants = append(grants, accessGrant{Source: effectiveAccessSourceDirect, Access: userResp.GetUser().GetSpec().GetAccess()})
+
+		groups, err := findUserGroupsOfUser(ctx, d.client, principalID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get user groups", err.Error())
+			return
+		}
+		for _, group := range groups {
+			grants = append(grants, accessGrant{Source: "group:" + group.GetId(), Access: group.GetSpec().GetAccess()})
+			state.Groups = append(state.Groups, effectiveAccessGroupDataModel{
+				ID:          types.StringValue(group.GetId()),
+				DisplayName: types.StringValue(group.GetSpec().GetDisplayName()),
+			})
+		}
+	case principalTypeServiceAccount:
+		saResp, err := d.client.CloudService().GetServiceAccount(ctx, &cloudservicev1.GetServiceAccountRequest{ServiceAccountId: principalID})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get service account", err.Error())
+			return
+		}
+		spec := saResp.GetServiceAccount().GetSpec()
+		if scoped := spec.GetNamespaceScopedAccess(); scoped != nil {
+			grants = append(grants, accessGrant{
+				Source: effectiveAccessSourceNamespaceScopedAccess,
+				Access: &identityv1.Access{
+					NamespaceAccesses: map[string]*identityv1.NamespaceAccess{scoped.GetNamespace(): scoped.GetAccess()},
+				},
+			})
+		} else {
+			grants = append(grants, accessGrant{Source: effectiveAccessSourceDirect, Access: spec.GetAccess()})
+		}
+	case principalTypeGroup:
+		groupResp, err := d.client.CloudService().GetUserGroup(ctx, &cloudservicev1.GetUserGroupRequest{GroupId: principalID})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get group", err.Error())
+			return
+		}
+		grants = append(grants, accessGrant{Source: effectiveAccessSourceDirect, Access: groupResp.GetGroup().GetSpec().GetAccess()})
+	}
+
+	access := flattenEffectiveAccess(grants)
+
+	state.AccountAccesses = make([]effectiveAccountAccessDataModel, 0, len(access.AccountRoles))
+	implicitNamespaceAdmin := false
+	for _, grant := range access.AccountRoles {
+		role, err := enums.FromAccountAccessRole(grant.Role)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to convert account access role", err.Error())
+			return
+		}
+		state.AccountAccesses = append(state.AccountAccesses, effectiveAccountAccessDataModel{
+			Role:   types.StringValue(role),
+			Source: types.StringValue(grant.Source),
+		})
+		if grant.Role == identityv1.AccountAccess_ROLE_OWNER || grant.Role == identityv1.AccountAccess_ROLE_ADMIN {
+			implicitNamespaceAdmin = true
+		}
+	}
+	state.ImplicitNamespaceAdmin = types.BoolValue(implicitNamespaceAdmin)
+
+	state.NamespaceAccesses = make([]effectiveNamespaceAccessDataModel, 0, len(access.NamespaceAccesses))
+	for _, grant := range access.NamespaceAccesses {
+		permission, err := enums.FromNamespaceAccessPermission(grant.Permission)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to convert namespace access permission", err.Error())
+			return
+		}
+		state.NamespaceAccesses = append(state.NamespaceAccesses, effectiveNamespaceAccessDataModel{
+			NamespaceID: types.StringValue(grant.Namespace),
+			Permission:  types.StringValue(permission),
+			Source:      types.StringValue(grant.Source),
+		})
+	}
+
+	state.CustomRoles = make([]effectiveCustomRoleDataModel, 0, len(access.CustomRoles))
+	for _, grant := range access.CustomRoles {
+		customRoleResp, err := d.client.CloudService().GetCustomRole(ctx, &cloudservicev1.GetCustomRoleRequest{RoleId: grant.ID})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get custom role", err.Error())
+			return
+		}
+		state.CustomRoles = append(state.CustomRoles, effectiveCustomRoleDataModel{
+			ID:     types.StringValue(grant.ID),
+			Name:   types.StringValue(customRoleResp.GetCustomRole().GetSpec().GetName()),
+			Source: types.StringValue(grant.Source),
+		})
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s-%s-effective-access", state.PrincipalType.ValueString(), principalID))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenEffectiveAccess combines grants into the effective access of a principal. Every account role grant
+// is kept. For each namespace the most privileged grant wins; on ties the earlier grant is reported as source.
+func flattenEffectiveAccess(grants []accessGrant) effectiveAccess {
+	var access effectiveAccess
+	customRoles := make(map[string]bool)
+	namespaces := make(map[string]int)
+	for _, grant := range grants {
+		accountAccess := grant.Access.GetAccountAccess()
+		if role := accountAccess.GetRole(); role != identityv1.AccountAccess_ROLE_UNSPECIFIED {
+			access.AccountRoles = append(access.AccountRoles, effectiveAccountRoleGrant{Role: role, Source: grant.Source})
+		}
+
+		for _, id := range accountAccess.GetCustomRoles() {
+			if customRoles[id] {
+				continue
+			}
+			customRoles[id] = true
+			access.CustomRoles = append(access.CustomRoles, effectiveGrant{ID: id, Source: grant.Source})
+		}
+
+		// Iterate namespaces in order so that ties are resolved deterministically.
+		namespaceIDs := make([]string, 0, len(grant.Access.GetNamespaceAccesses()))
+		for namespace := range grant.Access.GetNamespaceAccesses() {
+			namespaceIDs = append(namespaceIDs, namespace)
+		}
+		sort.Strings(namespaceIDs)
+		for _, namespace := range namespaceIDs {
+			permission := grant.Access.GetNamespaceAccesses()[namespace].GetPermission()
+			i, ok := namespaces[namespace]
+			if !ok {
+				namespaces[namespace] = len(access.NamespaceAccesses)
+				access.NamespaceAccesses = append(access.NamespaceAccesses, effectiveNamespaceGrant{
+					Namespace:  namespace,
+					Permission: permission,
+					Source:     grant.Source,
+				})
+				continue
+			}
+			if namespaceAccessPermissionRanks[permission] > namespaceAccessPermissionRanks[access.NamespaceAccesses[i].Permission] {
+				access.NamespaceAccesses[i].Permission = permission
+				access.NamespaceAccesses[i].Source = grant.Source
+			}
+		}
+	}
+
+	sort.Slice(access.NamespaceAccesses, func(i, j int) bool {
+		return access.NamespaceAccesses[i].Namespace < access.NamespaceAccesses[j].Namespace
+	})
+	return access
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+func TestEffectiveAccessDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewEffectiveAccessDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestFlattenEffectiveAccess(t *testing.T) {
+	t.Parallel()
+
+	namespaceAccess := func(permission identityv1.NamespaceAccess_Permission) *identityv1.NamespaceAccess {
+		return &identityv1.NamespaceAccess{Permission: permission}
+	}
+
+	access := flattenEffectiveAccess([]accessGrant{
+		{
+			Source: effectiveAccessSourceDirect,
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{
+					Role:        identityv1.AccountAccess_ROLE_READ,
+					CustomRoles: []string{"role-a"},
+				},
+				NamespaceAccesses: map[string]*identityv1.NamespaceAccess{
+					"ns-b.acct": namespaceAccess(identityv1.NamespaceAccess_PERMISSION_WRITE),
+					"ns-a.acct": namespaceAccess(identityv1.NamespaceAccess_PERMISSION_READ),
+				},
+			},
+		},
+		{
+			Source: "group:group-1",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{
+					Role:        identityv1.AccountAccess_ROLE_DEVELOPER,
+					CustomRoles: []string{"role-a", "role-b"},
+				},
+				NamespaceAccesses: map[string]*identityv1.NamespaceAccess{
+					"ns-a.acct": namespaceAccess(identityv1.NamespaceAccess_PERMISSION_ADMIN),
+					"ns-b.acct": namespaceAccess(identityv1.NamespaceAccess_PERMISSION_WRITE),
+				},
+			},
+		},
+		{
+			Source: "group:group-2",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_FINANCE_ADMIN},
+			},
+		},
+		{
+			Source: "group:group-3",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_UNSPECIFIED},
+			},
+		},
+	})
+
+	want := effectiveAccess{
+		AccountRoles: []effectiveAccountRoleGrant{
+			{Role: identityv1.AccountAccess_ROLE_READ, Source: effectiveAccessSourceDirect},
+			{Role: identityv1.AccountAccess_ROLE_DEVELOPER, Source: "group:group-1"},
+			{Role: identityv1.AccountAccess_ROLE_FINANCE_ADMIN, Source: "group:group-2"},
+		},
+		CustomRoles: []effectiveGrant{
+			{ID: "role-a", Source: effectiveAccessSourceDirect},
+			{ID: "role-b", Source: "group:group-1"},
+		},
+		NamespaceAccesses: []effectiveNamespaceGrant{
+			{Namespace: "ns-a.acct", Permission: identityv1.NamespaceAccess_PERMISSION_ADMIN, Source: "group:group-1"},
+			{Namespace: "ns-b.acct", Permission: identityv1.NamespaceAccess_PERMISSION_WRITE, Source: effectiveAccessSourceDirect},
+		},
+	}
+	if !reflect.DeepEqual(access, want) {
+		t.Errorf("flattenEffectiveAccess() = %+v, want %+v", access, want)
+	}
+
+	if access := flattenEffectiveAccess(nil); len(access.AccountRoles) != 0 {
+		t.Errorf("flattenEffectiveAccess(nil) = %+v, want no access", access)
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 var (
 	ErrInvalidNamespaceSearchAttribute = errors.New("invalid namespace search attribute")
+	ErrInvalidExportSinkHealth         = errors.New("invalid export sink health")
+	ErrInvalidCapacityRequestState     = errors.New("invalid capacity request state")
 )
 
 func ToNamespaceSearchAttribute(s string, strict bool) (namespace.NamespaceSpec_SearchAttributeType, error) {
@@ -58,3 +60,33 @@ func FromNamespaceSearchAttribute(r namespace.NamespaceSpec_SearchAttributeType)
 		return "", fmt.Errorf("%w: %v", ErrInvalidNamespaceSearchAttribute, r)
 	}
 }
+
+func FromExportSinkHealth(h namespace.ExportSink_Health) (string, error) {
+	switch h {
+	case namespace.ExportSink_HEALTH_UNSPECIFIED:
+		return "unspecified", nil
+	case namespace.ExportSink_HEALTH_OK:
+		return "ok", nil
+	case namespace.ExportSink_HEALTH_ERROR_INTERNAL:
+		return "errorinternal", nil
+	case namespace.ExportSink_HEALTH_ERROR_USER_CONFIGURATION:
+		return "erroruserconfiguration", nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrInvalidExportSinkHealth, h)
+	}
+}
+
+func FromCapacityRequestState(s namespace.Capacity_Request_State) (string, error) {
+	switch s {
+	case namespace.Capacity_Request_STATE_CAPACITY_REQUEST_UNSPECIFIED:
+		return "unspecified", nil
+	case namespace.Capacity_Request_STATE_CAPACITY_REQUEST_COMPLETED:
+		return "completed", nil
+	case namespace.Capacity_Request_STATE_CAPACITY_REQUEST_IN_PROGRESS:
+		return "inprogress", nil
+	case namespace.Capacity_Request_STATE_CAPACITY_REQUEST_FAILED:
+		return "failed", nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrInvalidCapacityRequestState, s)
+	}
+}
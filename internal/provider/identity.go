@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+// findUserByEmail returns the user with the given email address, compared case-insensitively, or nil if
+// there is no such user.
+func findUserByEmail(ctx context.Context, c *client.Client, email string) (*identityv1.User, error) {
+	users, err := getUsersByEmail(ctx, c, email, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.GetSpec().GetEmail(), email) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// getUsersByEmail returns the users of the namespace, if set, that include the users with the given email
+// address, compared case-insensitively. The email is filtered by the API, which may compare it case-sensitively,
+// so all users of the namespace are listed if the filtered users do not include the email.
+func getUsersByEmail(ctx context.Context, c *client.Client, email string, namespace string) ([]*identityv1.User, error) {
+	users, err := getUsers(ctx, c, &cloudservicev1.GetUsersRequest{Email: email, Namespace: namespace})
+	if err != nil || email == "" {
+		return users, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.GetSpec().GetEmail(), email) {
+			return users, nil
+		}
+	}
+
+	return getUsers(ctx, c, &cloudservicev1.GetUsersRequest{Namespace: namespace})
+}
+
+// getUsers returns all pages of users of the request.
+func getUsers(ctx context.Context, c *client.Client, req *cloudservicev1.GetUsersRequest) ([]*identityv1.User, error) {
+	var users []*identityv1.User
+	for {
+		r, err := c.CloudService().GetUsers(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, r.GetUsers()...)
+
+		if r.GetNextPageToken() == "" {
+			return users, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// findServiceAccountByName returns the service account with the given name, or nil if there is no such
+// service account. It fails if the name is ambiguous.
+func findServiceAccountByName(ctx context.Context, c *client.Client, name string) (*identityv1.ServiceAccount, error) {
+	var found *identityv1.ServiceAccount
+	req := &cloudservicev1.GetServiceAccountsRequest{}
+	for {
+		r, err := c.CloudService().GetServiceAccounts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, serviceAccount := range r.GetServiceAccount() {
+			if serviceAccount.GetSpec().GetName() != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("found more than one service account named %q", name)
+			}
+			found = serviceAccount
+		}
+
+		if r.GetNextPageToken() == "" {
+			return found, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// findNamespaceScopedServiceAccounts returns the sorted names of the service accounts scoped to the namespace.
+func findNamespaceScopedServiceAccounts(ctx context.Context, c *client.Client, namespaceID string) ([]string, error) {
+	var names []string
+	req := &cloudservicev1.GetServiceAccountsRequest{}
+	for {
+		r, err := c.CloudService().GetServiceAccounts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, serviceAccount := range r.GetServiceAccount() {
+			if serviceAccount.GetSpec().GetNamespaceScopedAccess().GetNamespace() == namespaceID {
+				names = append(names, serviceAccount.GetSpec().GetName())
+			}
+		}
+
+		if r.GetNextPageToken() == "" {
+			slices.Sort(names)
+			return names, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// findSCIMGroupByIdpID returns the SCIM group with the given IDP ID, or nil if there is no such group. It fails
+// if the IDP ID is ambiguous.
+func findSCIMGroupByIdpID(ctx context.Context, c *client.Client, idpID string) (*identityv1.UserGroup, error) {
+	var found *identityv1.UserGroup
+	req := &cloudservicev1.GetUserGroupsRequest{
+		ScimGroup: &cloudservicev1.GetUserGroupsRequest_SCIMGroupFilter{
+			IdpId: idpID,
+		},
+	}
+	for {
+		r, err := c.CloudService().GetUserGroups(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range r.GetGroups() {
+			if group.GetSpec().GetScimGroup().GetIdpId() != idpID {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("found more than one SCIM group with IDP ID %q", idpID)
+			}
+			found = group
+		}
+
+		if r.GetNextPageToken() == "" {
+			return found, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// findUserGroupsOfUser returns the user groups the user with the given ID is a member of.
+func findUserGroupsOfUser(ctx context.Context, c *client.Client, userID string) ([]*identityv1.UserGroup, error) {
+	var groups []*identityv1.UserGroup
+	groupsReq := &cloudservicev1.GetUserGroupsRequest{}
+	for {
+		r, err := c.CloudService().GetUserGroups(ctx, groupsReq)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range r.GetGroups() {
+			isMember, err := isUserGroupMember(ctx, c, group.GetId(), userID)
+			if err != nil {
+				return nil, err
+			}
+			if isMember {
+				groups = append(groups, group)
+			}
+		}
+
+		if r.GetNextPageToken() == "" {
+			return groups, nil
+		}
+
+		groupsReq.PageToken = r.GetNextPageToken()
+	}
+}
+
+// isUserGroupMember reports whether the user with the given ID is a member of the group.
+func isUserGroupMember(ctx context.Context, c *client.Client, groupID string, userID string) (bool, error) {
+	req := &cloudservicev1.GetUserGroupMembersRequest{GroupId: groupID}
+	for {
+		r, err := c.CloudService().GetUserGroupMembers(ctx, req)
+		if err != nil {
+			return false, err
+		}
+
+		for _, member := range r.GetMembers() {
+			if member.GetMemberId().GetUserId() == userID {
+				return true, nil
+			}
+		}
+
+		if r.GetNextPageToken() == "" {
+			return false, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// countUserGroupMembers returns the number of users in the group with the given ID.
+func countUserGroupMembers(ctx context.Context, c *client.Client, groupID string) (int, error) {
+	count := 0
+	req := &cloudservicev1.GetUserGroupMembersRequest{GroupId: groupID}
+	for {
+		r, err := c.CloudService().GetUserGroupMembers(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(r.GetMembers())
+
+		if r.GetNextPageToken() == "" {
+			return count, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+// isUserManagedBySCIM reports whether the user with the given ID is a member of a SCIM group.
+func isUserManagedBySCIM(ctx context.Context, c *client.Client, userID string) (bool, error) {
+	groupsReq := &cloudservicev1.GetUserGroupsRequest{}
+	for {
+		r, err := c.CloudService().GetUserGroups(ctx, groupsReq)
+		if err != nil {
+			return false, err
+		}
+
+		for _, group := range r.GetGroups() {
+			if group.GetSpec().GetScimGroup() == nil {
+				continue
+			}
+			isMember, err := isUserGroupMember(ctx, c, group.GetId(), userID)
+			if err != nil {
+				return false, err
+			}
+			if isMember {
+				return true, nil
+			}
+		}
+
+		if r.GetNextPageToken() == "" {
+			return false, nil
+		}
+
+		groupsReq.PageToken = r.GetNextPageToken()
+	}
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jpillora/maplock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	operationv1 "go.temporal.io/cloud-sdk/api/operation/v1"
+)
+
+type (
+	namespaceAccessResource struct {
+		client *client.Client
+	}
+
+	namespaceAccessResourceModel struct {
+		ID            types.String                             `tfsdk:"id"`
+		NamespaceID   types.String                             `tfsdk:"namespace_id"`
+		PrincipalID   types.String                             `tfsdk:"principal_id"`
+		PrincipalType types.String                             `tfsdk:"principal_type"`
+		Permission    internaltypes.CaseInsensitiveStringValue `tfsdk:"permission"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*namespaceAccessResource)(nil)
+	_ resource.ResourceWithConfigure   = (*namespaceAccessResource)(nil)
+	_ resource.ResourceWithImportState = (*namespaceAccessResource)(nil)
+
+	// principalLocks is a per-principal mutex that protects against concurrent updates to the access of the same
+	// user, service account or group, which can happen when we are modifying multiple namespace accesses in parallel.
+	principalLocks = maplock.New()
+)
+
+func NewNamespaceAccessResource() resource.Resource {
+	return &namespaceAccessResource{}
+}
+
+func (r *namespaceAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *namespaceAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_access"
+}
+
+func (r *namespaceAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants a single user, service account or group a permission on a namespace, without managing the rest of its access. The principal's own resource (`temporalcloud_user`, `temporalcloud_service_account` or `temporalcloud_group_access`) should not manage the same namespace, and should ignore changes to `namespace_accesses` with a `lifecycle` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this namespace access, formatted as `namespace_id/principal_type/principal_id`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Description: "The ID of the namespace to grant the permission on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The ID of the user, service account or group to grant the permission to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The type of the principal. One of user, service-account or group.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(principalTypeUser, principalTypeServiceAccount, principalTypeGroup),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				CustomType:  internaltypes.CaseInsensitiveStringType{},
+				Description: "The permission to grant. Must be one of admin, write, or read (case-insensitive).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(enums.AllowedNamespaceAccessPermissions()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *namespaceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan namespaceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withPrincipalLock(plan.PrincipalID.ValueString(), func() {
+		access, resourceVersion, err := r.getPrincipalAccess(ctx, plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get principal", err.Error())
+			return
+		}
+		if _, present := access.GetNamespaceAccesses()[plan.NamespaceID.ValueString()]; present {
+			resp.Diagnostics.AddError(
+				"Namespace access already exists",
+				fmt.Sprintf("The %s `%s` already has access to namespace `%s`, import it instead.", plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString(), plan.NamespaceID.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(r.setNamespaceAccess(ctx, &plan, resourceVersion, false)...)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(namespaceAccessID(plan.NamespaceID.ValueString(), plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *namespaceAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state namespaceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, _, err := r.getPrincipalAccess(ctx, state.PrincipalType.ValueString(), state.PrincipalID.ValueString())
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			tflog.Warn(ctx, "Namespace Access principal not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to get principal", err.Error())
+		return
+	}
+
+	namespaceAccess, present := access.GetNamespaceAccesses()[state.NamespaceID.ValueString()]
+	if !present {
+		tflog.Warn(ctx, "Namespace Access not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	permission, err := enums.FromNamespaceAccessPermission(namespaceAccess.GetPermission())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to convert namespace access permission", err.Error())
+		return
+	}
+	state.Permission = internaltypes.CaseInsensitiveString(permission)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *namespaceAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan namespaceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withPrincipalLock(plan.PrincipalID.ValueString(), func() {
+		_, resourceVersion, err := r.getPrincipalAccess(ctx, plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get principal", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(r.setNamespaceAccess(ctx, &plan, resourceVersion, false)...)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *namespaceAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state namespaceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withPrincipalLock(state.PrincipalID.ValueString(), func() {
+		access, resourceVersion, err := r.getPrincipalAccess(ctx, state.PrincipalType.ValueString(), state.PrincipalID.ValueString())
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				tflog.Warn(ctx, "Namespace Access principal not found, skipping deletion", map[string]interface{}{
+					"id": state.ID.ValueString(),
+				})
+				return
+			}
+
+			resp.Diagnostics.AddError("Failed to get principal", err.Error())
+			return
+		}
+		if _, present := access.GetNamespaceAccesses()[state.NamespaceID.ValueString()]; !present {
+			return
+		}
+
+		resp.Diagnostics.Append(r.setNamespaceAccess(ctx, &state, resourceVersion, true)...)
+	})
+}
+
+func (r *namespaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	components := strings.Split(req.ID, "/")
+	if len(components) != 3 {
+		resp.Diagnostics.AddError("Invalid import ID for Namespace access", "The import ID must be in the format `NamespaceID/PrincipalType/PrincipalID`, such as `yournamespace.deadbeef/user/a1b2c3d4e5f6`")
+		return
+	}
+
+	switch components[1] {
+	case principalTypeUser, principalTypeServiceAccount, principalTypeGroup:
+	default:
+		resp.Diagnostics.AddError("Invalid import ID for Namespace access", fmt.Sprintf("The principal type must be one of %s, %s or %s, got `%s`", principalTypeUser, principalTypeServiceAccount, principalTypeGroup, components[1]))
+		return
+	}
+
+	var state namespaceAccessResourceModel
+	state.ID = types.StringValue(req.ID)
+	state.NamespaceID = types.StringValue(components[0])
+	state.PrincipalType = types.StringValue(components[1])
+	state.PrincipalID = types.StringValue(components[2])
+	state.Permission = internaltypes.CaseInsensitiveStringValue{StringValue: types.StringNull()}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getPrincipalAccess returns the access and resource version of the given user, service account or group.
+func (r *namespaceAccessResource) getPrincipalAccess(ctx context.Context, principalType string, principalID string) (*identityv1.Access, string, error) {
+	switch principalType {
+	case principalTypeUser:
+		userResp, err := r.client.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{UserId: principalID})
+		if err != nil {
+			return nil, "", err
+		}
+		return userResp.GetUser().GetSpec().GetAccess(), userResp.GetUser().GetResourceVersion(), nil
+	case principalTypeServiceAccount:
+		saResp, err := r.client.CloudService().GetServiceAccount(ctx, &cloudservicev1.GetServiceAccountRequest{ServiceAccountId: principalID})
+		if err != nil {
+			return nil, "", err
+		}
+		return saResp.GetServiceAccount().GetSpec().GetAccess(), saResp.GetServiceAccount().GetResourceVersion(), nil
+	case principalTypeGroup:
+		groupResp, err := r.client.CloudService().GetUserGroup(ctx, &cloudservicev1.GetUserGroupRequest{GroupId: principalID})
+		if err != nil {
+			return nil, "", err
+		}
+		return groupResp.GetGroup().GetSpec().GetAccess(), groupResp.GetGroup().GetResourceVersion(), nil
+	default:
+		return nil, "", fmt.Errorf("invalid principal type %q", principalType)
+	}
+}
+
+// setNamespaceAccess sets the permission of model on the namespace, or removes the namespace access of the
+// principal if remove is true, and waits for the operation to complete.
+func (r *namespaceAccessResource) setNamespaceAccess(ctx context.Context, model *namespaceAccessResourceModel, resourceVersion string, remove bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var access *identityv1.NamespaceAccess
+	if !remove {
+		permission, err := enums.ToNamespaceAccessPermission(model.Permission.ValueString())
+		if err != nil {
+			diags.AddError("Failed to convert namespace permission", err.Error())
+			return diags
+		}
+		access = &identityv1.NamespaceAccess{Permission: permission}
+	}
+
+	var op *operationv1.AsyncOperation
+	switch model.PrincipalType.ValueString() {
+	case principalTypeUser:
+		svcResp, err := r.client.CloudService().SetUserNamespaceAccess(ctx, &cloudservicev1.SetUserNamespaceAccessRequest{
+			Namespace:        model.NamespaceID.ValueString(),
+			UserId:           model.PrincipalID.ValueString(),
+			Access:           access,
+			ResourceVersion:  resourceVersion,
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			diags.AddError("Failed to set user namespace access", err.Error())
+			return diags
+		}
+		op = svcResp.GetAsyncOperation()
+	case principalTypeServiceAccount:
+		svcResp, err := r.client.CloudService().SetServiceAccountNamespaceAccess(ctx, &cloudservicev1.SetServiceAccountNamespaceAccessRequest{
+			ServiceAccountId: model.PrincipalID.ValueString(),
+			Namespace:        model.NamespaceID.ValueString(),
+			Access:           access,
+			ResourceVersion:  resourceVersion,
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			diags.AddError("Failed to set service account namespace access", err.Error())
+			return diags
+		}
+		op = svcResp.GetAsyncOperation()
+	case principalTypeGroup:
+		svcResp, err := r.client.CloudService().SetUserGroupNamespaceAccess(ctx, &cloudservicev1.SetUserGroupNamespaceAccessRequest{
+			Namespace:        model.NamespaceID.ValueString(),
+			GroupId:          model.PrincipalID.ValueString(),
+			Access:           access,
+			ResourceVersion:  resourceVersion,
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			diags.AddError("Failed to set group namespace access", err.Error())
+			return diags
+		}
+		op = svcResp.GetAsyncOperation()
+	default:
+		diags.AddError("Invalid principal type", fmt.Sprintf("invalid principal type %q", model.PrincipalType.ValueString()))
+		return diags
+	}
+
+	if err := client.AwaitAsyncOperation(ctx, r.client, op); err != nil {
+		diags.AddError("Failed to set namespace access", err.Error())
+	}
+	return diags
+}
+
+func namespaceAccessID(namespaceID string, principalType string, principalID string) string {
+	return fmt.Sprintf("%s/%s/%s", namespaceID, principalType, principalID)
+}
+
+// withPrincipalLock locks the given principal and runs the given function, releasing the lock once the function returns.
+func withPrincipalLock(principalID string, f func()) {
+	principalLocks.Lock(principalID)
+	defer func() {
+		_ = principalLocks.Unlock(principalID)
+	}()
+	f()
+}
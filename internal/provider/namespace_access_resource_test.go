@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamespaceAccessSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	NewNamespaceAccessResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAccNamespaceAccess(t *testing.T) {
+	namespaceName := fmt.Sprintf("%s-%s", "tf-namespace-access", randomString(10))
+	serviceAccountName := createRandomName()
+	config := func(permission string) string {
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_namespace" "test" {
+  name           = "%s"
+  regions        = ["aws-ca-central-1"]
+  api_key_auth   = true
+  retention_days = 7
+}
+
+resource "temporalcloud_service_account" "test" {
+  name           = "%s"
+  account_access = "read"
+
+  lifecycle {
+    ignore_changes = [namespace_accesses]
+  }
+}
+
+resource "temporalcloud_namespace_access" "test" {
+  namespace_id   = temporalcloud_namespace.test.id
+  principal_id   = temporalcloud_service_account.test.id
+  principal_type = "service-account"
+  permission     = "%s"
+}`, namespaceName, serviceAccountName, permission)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_namespace_access.test", "permission", "read"),
+				),
+			},
+			{
+				Config: config("write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_namespace_access.test", "permission", "write"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "temporalcloud_namespace_access.test",
+			},
+		},
+	})
+}
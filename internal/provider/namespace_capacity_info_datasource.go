@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	namespacev1 "go.temporal.io/cloud-sdk/api/namespace/v1"
+)
+
+type (
+	namespaceCapacityInfoDataSource struct {
+		client *client.Client
+	}
+
+	namespaceCapacityInfoDataModel struct {
+		Namespace              types.String              `tfsdk:"namespace"`
+		HasLegacyLimits        types.Bool                `tfsdk:"has_legacy_limits"`
+		CurrentMode            types.String              `tfsdk:"current_mode"`
+		CurrentValue           types.Float64             `tfsdk:"current_value"`
+		ValidProvisionedValues []types.Float64           `tfsdk:"valid_provisioned_values"`
+		MaxProvisionedValue    types.Float64             `tfsdk:"max_provisioned_value"`
+		OnDemandApsLimit       types.Float64             `tfsdk:"on_demand_aps_limit"`
+		ApsMean                types.Float64             `tfsdk:"aps_mean"`
+		ApsP90                 types.Float64             `tfsdk:"aps_p90"`
+		ApsP99                 types.Float64             `tfsdk:"aps_p99"`
+		LatestRequest          *capacityRequestDataModel `tfsdk:"latest_request"`
+	}
+
+	capacityRequestDataModel struct {
+		State            types.String  `tfsdk:"state"`
+		Mode             types.String  `tfsdk:"mode"`
+		Value            types.Float64 `tfsdk:"value"`
+		StartTime        types.String  `tfsdk:"start_time"`
+		EndTime          types.String  `tfsdk:"end_time"`
+		AsyncOperationID types.String  `tfsdk:"async_operation_id"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*namespaceCapacityInfoDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*namespaceCapacityInfoDataSource)(nil)
+)
+
+func NewNamespaceCapacityInfoDataSource() datasource.DataSource {
+	return &namespaceCapacityInfoDataSource{}
+}
+
+func (d *namespaceCapacityInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_capacity_info"
+}
+
+func (d *namespaceCapacityInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *namespaceCapacityInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current capacity of a namespace, the capacity values it can be changed to, and the state of the latest capacity change.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description: "The namespace to fetch capacity information for, formatted as `<namespace>.<account_id>`.",
+				Required:    true,
+			},
+			"has_legacy_limits": schema.BoolAttribute{
+				Description: "True if the namespace still uses legacy limits instead of a capacity mode.",
+				Computed:    true,
+			},
+			"current_mode": schema.StringAttribute{
+				Description: "The current capacity mode of the namespace. One of 'provisioned' or 'on_demand'. Null if the namespace has no capacity mode.",
+				Computed:    true,
+			},
+			"current_value": schema.Float64Attribute{
+				Description: "The current provisioned capacity value of the namespace. Null unless current_mode is 'provisioned'.",
+				Computed:    true,
+			},
+			"valid_provisioned_values": schema.ListAttribute{
+				Description: "The capacity values the namespace can be provisioned with, ordered as returned by the API.",
+				Computed:    true,
+				ElementType: types.Float64Type,
+			},
+			"max_provisioned_value": schema.Float64Attribute{
+				Description: "The maximum capacity value currently available for the namespace in provisioned mode.",
+				Computed:    true,
+			},
+			"on_demand_aps_limit": schema.Float64Attribute{
+				Description: "The actions per second limit of the namespace in on-demand mode.",
+				Computed:    true,
+			},
+			"aps_mean": schema.Float64Attribute{
+				Description: "The mean actions per second of the namespace over the last 7 days.",
+				Computed:    true,
+			},
+			"aps_p90": schema.Float64Attribute{
+				Description: "The 90th percentile of actions per second of the namespace over the last 7 days.",
+				Computed:    true,
+			},
+			"aps_p99": schema.Float64Attribute{
+				Description: "The 99th percentile of actions per second of the namespace over the last 7 days.",
+				Computed:    true,
+			},
+			"latest_request": schema.SingleNestedAttribute{
+				Description: "The latest capacity change requested for the namespace. Null if the capacity was never changed.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"state": schema.StringAttribute{
+						Description: "The state of the capacity change. One of unspecified, completed, inprogress, or failed.",
+						Computed:    true,
+					},
+					"mode": schema.StringAttribute{
+						Description: "The capacity mode requested. One of 'provisioned' or 'on_demand'.",
+						Computed:    true,
+					},
+					"value": schema.Float64Attribute{
+						Description: "The capacity value requested. Null unless mode is 'provisioned'.",
+						Computed:    true,
+					},
+					"start_time": schema.StringAttribute{
+						Description: "The time the capacity change was requested, formatted as RFC3339.",
+						Computed:    true,
+					},
+					"end_time": schema.StringAttribute{
+						Description: "The time the capacity change completed, formatted as RFC3339. Null while the change is in progress.",
+						Computed:    true,
+					},
+					"async_operation_id": schema.StringAttribute{
+						Description: "The ID of the async operation applying the capacity change.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *namespaceCapacityInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var input namespaceCapacityInfoDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(input.Namespace.ValueString()) == 0 {
+		resp.Diagnostics.AddError("invalid namespace", "namespace is required")
+		return
+	}
+
+	capacityResp, err := d.client.CloudService().GetNamespaceCapacityInfo(ctx, &cloudservicev1.GetNamespaceCapacityInfoRequest{
+		Namespace: input.Namespace.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get namespace capacity info", err.Error())
+		return
+	}
+
+	info := capacityResp.GetCapacityInfo()
+	state := namespaceCapacityInfoDataModel{
+		Namespace:           input.Namespace,
+		HasLegacyLimits:     types.BoolValue(info.GetHasLegacyLimits()),
+		MaxProvisionedValue: types.Float64Value(info.GetModeOptions().GetProvisioned().GetMaxAvailableTruValue()),
+		OnDemandApsLimit:    types.Float64Value(info.GetModeOptions().GetOnDemand().GetApsLimit()),
+		ApsMean:             types.Float64Value(info.GetStats().GetAps().GetMean()),
+		ApsP90:              types.Float64Value(info.GetStats().GetAps().GetP90()),
+		ApsP99:              types.Float64Value(info.GetStats().GetAps().GetP99()),
+	}
+
+	state.CurrentMode, state.CurrentValue = types.StringNull(), types.Float64Null()
+	switch {
+	case info.GetCurrentCapacity().GetOnDemand() != nil:
+		state.CurrentMode = types.StringValue("on_demand")
+	case info.GetCurrentCapacity().GetProvisioned() != nil:
+		state.CurrentMode = types.StringValue("provisioned")
+		state.CurrentValue = types.Float64Value(info.GetCurrentCapacity().GetProvisioned().GetCurrentValue())
+	}
+
+	state.ValidProvisionedValues = make([]types.Float64, 0, len(info.GetModeOptions().GetProvisioned().GetValidTruValues()))
+	for _, v := range info.GetModeOptions().GetProvisioned().GetValidTruValues() {
+		state.ValidProvisionedValues = append(state.ValidProvisionedValues, types.Float64Value(v))
+	}
+
+	if latest := info.GetCurrentCapacity().GetLatestRequest(); latest != nil {
+		requestState, err := enums.FromCapacityRequestState(latest.GetState())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to convert capacity request state", err.Error())
+			return
+		}
+
+		request := &capacityRequestDataModel{
+			State:            types.StringValue(requestState),
+			Mode:             types.StringNull(),
+			Value:            types.Float64Null(),
+			StartTime:        types.StringNull(),
+			EndTime:          types.StringNull(),
+			AsyncOperationID: types.StringValue(latest.GetAsyncOperationId()),
+		}
+		switch {
+		case latest.GetSpec().GetOnDemand() != nil:
+			request.Mode = types.StringValue("on_demand")
+		case latest.GetSpec().GetProvisioned() != nil:
+			request.Mode = types.StringValue("provisioned")
+			request.Value = types.Float64Value(latest.GetSpec().GetProvisioned().GetValue())
+		}
+		if latest.GetStartTime() != nil {
+			request.StartTime = types.StringValue(latest.GetStartTime().AsTime().Format(time.RFC3339))
+		}
+		if latest.GetEndTime() != nil {
+			request.EndTime = types.StringValue(latest.GetEndTime().AsTime().Format(time.RFC3339))
+		}
+		state.LatestRequest = request
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// isCapacityChangeInProgress reports whether the latest capacity change of the namespace has not completed yet.
+func isCapacityChangeInProgress(info *namespacev1.NamespaceCapacityInfo) bool {
+	return info.GetCurrentCapacity().GetLatestRequest().GetState() == namespacev1.Capacity_Request_STATE_CAPACITY_REQUEST_IN_PROGRESS
+}
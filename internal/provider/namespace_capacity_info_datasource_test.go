@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamespaceCapacityInfoDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewNamespaceCapacityInfoDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAccDataSource_NamespaceCapacityInfo(t *testing.T) {
+	name := fmt.Sprintf("%s-%s", "tf-capacity-info", randomString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceCapacityInfoConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.temporalcloud_namespace_capacity_info.test", "namespace", "temporalcloud_namespace.test", "id"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_capacity_info.test", "current_mode", "on_demand"),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_namespace_capacity_info.test", "on_demand_aps_limit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespaceCapacityInfoConfig(name string) string {
+	return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_namespace" "test" {
+  name           = "%s"
+  regions        = ["aws-us-east-1"]
+  api_key_auth   = true
+  retention_days = 7
+
+  capacity = {
+    mode = "on_demand"
+  }
+}
+
+data "temporalcloud_namespace_capacity_info" "test" {
+  namespace = temporalcloud_namespace.test.id
+}
+`, name)
+}
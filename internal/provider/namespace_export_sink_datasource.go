@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	namespacev1 "go.temporal.io/cloud-sdk/api/namespace/v1"
+)
+
+var (
+	_ datasource.DataSource              = &namespaceExportSinkDataSource{}
+	_ datasource.DataSourceWithConfigure = &namespaceExportSinkDataSource{}
+)
+
+func NewNamespaceExportSinkDataSource() datasource.DataSource {
+	return &namespaceExportSinkDataSource{}
+}
+
+type (
+	namespaceExportSinkDataSource struct {
+		client *client.Client
+	}
+
+	namespaceExportSinkDataSourceModel struct {
+		namespaceExportSinkDataModel
+		FailOnUnhealthy types.Bool `tfsdk:"fail_on_unhealthy"`
+	}
+
+	namespaceExportSinkDataModel struct {
+		Namespace           types.String `tfsdk:"namespace"`
+		SinkName            types.String `tfsdk:"sink_name"`
+		Enabled             types.Bool   `tfsdk:"enabled"`
+		S3                  types.Object `tfsdk:"s3"`
+		Gcs                 types.Object `tfsdk:"gcs"`
+		State               types.String `tfsdk:"state"`
+		Health              types.String `tfsdk:"health"`
+		ErrorMessage        types.String `tfsdk:"error_message"`
+		LastSucceededTime   types.String `tfsdk:"last_succeeded_time"`
+		LastHealthCheckTime types.String `tfsdk:"last_health_check_time"`
+	}
+)
+
+func namespaceExportSinkDataSourceSchema(nameRequired bool) map[string]schema.Attribute {
+	namespaceAttribute := schema.StringAttribute{
+		Description: "The namespace under which the sink is configured, formatted as `<namespace>.<account_id>`.",
+	}
+	sinkNameAttribute := schema.StringAttribute{
+		Description: "The unique name of the export sink.",
+	}
+
+	switch nameRequired {
+	case true:
+		namespaceAttribute.Required = true
+		sinkNameAttribute.Required = true
+	case false:
+		namespaceAttribute.Computed = true
+		sinkNameAttribute.Computed = true
+	}
+
+	return map[string]schema.Attribute{
+		"namespace": namespaceAttribute,
+		"sink_name": sinkNameAttribute,
+		"enabled": schema.BoolAttribute{
+			Description: "A flag indicating whether the export sink is enabled or not.",
+			Computed:    true,
+		},
+		"s3": schema.SingleNestedAttribute{
+			Description: "The S3 configuration details when destination_type is S3.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"role_name": schema.StringAttribute{
+					Description: "The IAM role that Temporal Cloud assumes for writing records to the customer's S3 bucket.",
+					Computed:    true,
+				},
+				"bucket_name": schema.StringAttribute{
+					Description: "The name of the destination S3 bucket where Temporal will send data.",
+					Computed:    true,
+				},
+				"region": schema.StringAttribute{
+					Description: "The region where the S3 bucket is located.",
+					Computed:    true,
+				},
+				"kms_arn": schema.StringAttribute{
+					Description: "The AWS Key Management Service (KMS) ARN used for encryption.",
+					Computed:    true,
+				},
+				"aws_account_id": schema.StringAttribute{
+					Description: "The AWS account ID associated with the S3 bucket and the assumed role.",
+					Computed:    true,
+				},
+			},
+		},
+		"gcs": schema.SingleNestedAttribute{
+			Description: "The GCS configuration details when destination_type is GCS.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"service_account_id": schema.StringAttribute{
+					Description: "The customer service account ID that Temporal Cloud impersonates for writing records to the customer's GCS bucket.",
+					Computed:    true,
+				},
+				"bucket_name": schema.StringAttribute{
+					Description: "The name of the destination GCS bucket where Temporal will send data.",
+					Computed:    true,
+				},
+				"gcp_project_id": schema.StringAttribute{
+					Description: "The GCP project ID associated with the GCS bucket and service account.",
+					Computed:    true,
+				},
+				"region": schema.StringAttribute{
+					Description: "The region of the gcs bucket",
+					Computed:    true,
+				},
+				"service_account_email": schema.StringAttribute{
+					Description: "The service account email associated with the GCS bucket and service account.",
+					Computed:    true,
+				},
+			},
+		},
+		"state": schema.StringAttribute{
+			Description: "The current state of the export sink.",
+			Computed:    true,
+		},
+		"health": schema.StringAttribute{
+			Description: "The health of the export sink as reported by the last health check. One of unspecified, ok, errorinternal, or erroruserconfiguration.",
+			Computed:    true,
+		},
+		"error_message": schema.StringAttribute{
+			Description: "The error message reported by the last health check, if the export sink is unhealthy.",
+			Computed:    true,
+		},
+		"last_succeeded_time": schema.StringAttribute{
+			Description: "The time of the last successful export, formatted as RFC3339. Null if no export has succeeded yet.",
+			Computed:    true,
+		},
+		"last_health_check_time": schema.StringAttribute{
+			Description: "The time of the last health check, formatted as RFC3339. Null if no health check has run yet.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *namespaceExportSinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_export_sink"
+}
+
+func (d *namespaceExportSinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *namespaceExportSinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := namespaceExportSinkDataSourceSchema(true)
+	attributes["fail_on_unhealthy"] = schema.BoolAttribute{
+		Description: "If set to true, reading the data source fails when the last health check reported the export sink as unhealthy. Defaults to false.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches details and health information about a namespace export sink.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *namespaceExportSinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var input namespaceExportSinkDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(input.Namespace.ValueString()) == 0 {
+		resp.Diagnostics.AddError("invalid namespace export sink namespace", "namespace export sink namespace is required")
+		return
+	}
+
+	if len(input.SinkName.ValueString()) == 0 {
+		resp.Diagnostics.AddError("invalid namespace export sink sink_name", "namespace export sink sink_name is required")
+		return
+	}
+
+	sinkResp, err := d.client.CloudService().GetNamespaceExportSink(ctx, &cloudservicev1.GetNamespaceExportSinkRequest{
+		Namespace: input.Namespace.ValueString(),
+		Name:      input.SinkName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get namespace export sink", err.Error())
+		return
+	}
+
+	model, diags := exportSinkToNamespaceExportSinkDataModel(ctx, input.Namespace.ValueString(), sinkResp.GetSink())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if input.FailOnUnhealthy.ValueBool() && isExportSinkUnhealthy(sinkResp.GetSink()) {
+		resp.Diagnostics.AddError(
+			"Namespace export sink is unhealthy",
+			fmt.Sprintf("Export sink %q in namespace %q reported health %q: %s", model.SinkName.ValueString(), model.Namespace.ValueString(), model.Health.ValueString(), model.ErrorMessage.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, namespaceExportSinkDataSourceModel{
+		namespaceExportSinkDataModel: *model,
+		FailOnUnhealthy:              input.FailOnUnhealthy,
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+func isExportSinkUnhealthy(sink *namespacev1.ExportSink) bool {
+	switch sink.GetHealth() {
+	case namespacev1.ExportSink_HEALTH_ERROR_INTERNAL, namespacev1.ExportSink_HEALTH_ERROR_USER_CONFIGURATION:
+		return true
+	default:
+		return false
+	}
+}
+
+func exportSinkToNamespaceExportSinkDataModel(ctx context.Context, namespace string, sink *namespacev1.ExportSink) (*namespaceExportSinkDataModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s3Obj, d := internaltypes.S3SpecToObject(ctx, sink.GetSpec().GetS3())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	gcsObj, d := internaltypes.GCSSpecToObject(ctx, sink.GetSpec().GetGcs())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	sinkStatus, d := exportSinkStatusFromProto(sink)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	model := new(namespaceExportSinkDataModel)
+	model.Namespace = types.StringValue(namespace)
+	model.SinkName = types.StringValue(sink.GetName())
+	model.Enabled = types.BoolValue(sink.GetSpec().GetEnabled())
+	model.S3 = s3Obj
+	model.Gcs = gcsObj
+	model.State = sinkStatus.State
+	model.Health = sinkStatus.Health
+	model.ErrorMessage = sinkStatus.ErrorMessage
+	model.LastSucceededTime = sinkStatus.LastSucceededTime
+	model.LastHealthCheckTime = sinkStatus.LastHealthCheckTime
+
+	return model, diags
+}
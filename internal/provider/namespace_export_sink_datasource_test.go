@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamespaceExportSinkDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewNamespaceExportSinkDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAccDataSource_NamespaceExportSink(t *testing.T) {
+	namespaceName := fmt.Sprintf("tf-test-ns-export-ds-%s", randomString(8))
+	sinkRegion := "ca-central-1"
+	namespaceRegion := fmt.Sprintf("aws-%s", sinkRegion)
+	sinkName := fmt.Sprintf("tf-test-sink-%s", randomString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceExportSinkDataSourceConfig(namespaceName, sinkName, namespaceRegion, sinkRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("temporalcloud_namespace_export_sink.test", "state"),
+					resource.TestCheckResourceAttrSet("temporalcloud_namespace_export_sink.test", "health"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sink.test", "sink_name", sinkName),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sink.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sink.test", "s3.bucket_name", "cloud-cicd-export-prod-cacentral1"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sink.test", "s3.region", sinkRegion),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_namespace_export_sink.test", "state"),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_namespace_export_sink.test", "health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespaceExportSinkDataSourceConfig(namespaceName, sinkName, namespaceRegion, sinkRegion string) string {
+	return fmt.Sprintf(`
+provider "temporalcloud" {
+}
+
+resource "temporalcloud_namespace" "terraform" {
+  name           = %[1]q
+  regions        = [%[2]q]
+  api_key_auth   = true
+  retention_days = 1
+}
+
+resource "temporalcloud_namespace_export_sink" "test" {
+  namespace = temporalcloud_namespace.terraform.id
+  sink_name = %[3]q
+  enabled   = true
+  s3 = {
+    bucket_name    = "cloud-cicd-export-prod-cacentral1"
+    region         = %[4]q
+    role_name      = "cloud-cicd-export-external-trust-prod-cacentral1"
+    aws_account_id = "471170916252"
+  }
+}
+
+data "temporalcloud_namespace_export_sink" "test" {
+  namespace = temporalcloud_namespace_export_sink.test.namespace
+  sink_name = temporalcloud_namespace_export_sink.test.sink_name
+}
+`, namespaceName, namespaceRegion, sinkName, sinkRegion)
+}
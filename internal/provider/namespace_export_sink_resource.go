@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -41,16 +42,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	namespacev1 "go.temporal.io/cloud-sdk/api/namespace/v1"
-	sinkv1 "go.temporal.io/cloud-sdk/api/sink/v1"
 )
 
 type (
@@ -59,13 +59,18 @@ type (
 	}
 
 	namespaceExportSinkResourceModel struct {
-		ID        types.String   `tfsdk:"id"`
-		Namespace types.String   `tfsdk:"namespace"`
-		SinkName  types.String   `tfsdk:"sink_name"`
-		Enabled   types.Bool     `tfsdk:"enabled"`
-		S3        types.Object   `tfsdk:"s3"`
-		Gcs       types.Object   `tfsdk:"gcs"`
-		Timeouts  timeouts.Value `tfsdk:"timeouts"`
+		ID                  types.String   `tfsdk:"id"`
+		Namespace           types.String   `tfsdk:"namespace"`
+		SinkName            types.String   `tfsdk:"sink_name"`
+		Enabled             types.Bool     `tfsdk:"enabled"`
+		S3                  types.Object   `tfsdk:"s3"`
+		Gcs                 types.Object   `tfsdk:"gcs"`
+		State               types.String   `tfsdk:"state"`
+		Health              types.String   `tfsdk:"health"`
+		ErrorMessage        types.String   `tfsdk:"error_message"`
+		LastSucceededTime   types.String   `tfsdk:"last_succeeded_time"`
+		LastHealthCheckTime types.String   `tfsdk:"last_health_check_time"`
+		Timeouts            timeouts.Value `tfsdk:"timeouts"`
 	}
 )
 
@@ -199,6 +204,26 @@ func (r *namespaceExportSinkResource) Schema(ctx context.Context, req resource.S
 					}...),
 				},
 			},
+			"state": schema.StringAttribute{
+				Description: "The current state of the export sink.",
+				Computed:    true,
+			},
+			"health": schema.StringAttribute{
+				Description: "The health of the export sink as reported by the last health check. One of unspecified, ok, errorinternal, or erroruserconfiguration.",
+				Computed:    true,
+			},
+			"error_message": schema.StringAttribute{
+				Description: "The error message reported by the last health check, if the export sink is unhealthy.",
+				Computed:    true,
+			},
+			"last_succeeded_time": schema.StringAttribute{
+				Description: "The time of the last successful export, formatted as RFC3339. Null if no export has succeeded yet.",
+				Computed:    true,
+			},
+			"last_health_check_time": schema.StringAttribute{
+				Description: "The time of the last health check, formatted as RFC3339. Null if no health check has run yet.",
+				Computed:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -268,42 +293,22 @@ func (r *namespaceExportSinkResource) Create(ctx context.Context, req resource.C
 func updateSinkModelFromSpec(ctx context.Context, state *namespaceExportSinkResourceModel, sink *namespacev1.ExportSink, namespace string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	s3Obj := types.ObjectNull(internaltypes.S3SpecModelAttrTypes)
-	if sink.GetSpec().GetS3() != nil {
-		s3Spec := internaltypes.S3SpecModel{
-			RoleName:     types.StringValue(sink.GetSpec().GetS3().GetRoleName()),
-			BucketName:   types.StringValue(sink.GetSpec().GetS3().GetBucketName()),
-			Region:       types.StringValue(sink.GetSpec().GetS3().GetRegion()),
-			AwsAccountId: types.StringValue(sink.GetSpec().GetS3().GetAwsAccountId()),
-		}
-
-		if sink.GetSpec().GetS3().GetKmsArn() != "" {
-			s3Spec.KmsArn = types.StringValue(sink.GetSpec().GetS3().GetKmsArn())
-		}
-
-		s3Obj, diags = types.ObjectValueFrom(ctx, internaltypes.S3SpecModelAttrTypes, s3Spec)
-		diags.Append(diags...)
-		if diags.HasError() {
-			return diags
-		}
+	s3Obj, d := internaltypes.S3SpecToObject(ctx, sink.GetSpec().GetS3())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
 
-	gcsObj := types.ObjectNull(internaltypes.GcsSpecModelAttrTypes)
-	if sink.GetSpec().GetGcs() != nil {
-		saEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", sink.GetSpec().GetGcs().GetSaId(), sink.GetSpec().GetGcs().GetGcpProjectId())
-		gcsSpec := internaltypes.GCSSpecModel{
-			SaId:                types.StringValue(sink.GetSpec().GetGcs().GetSaId()),
-			BucketName:          types.StringValue(sink.GetSpec().GetGcs().GetBucketName()),
-			GcpProjectId:        types.StringValue(sink.GetSpec().GetGcs().GetGcpProjectId()),
-			Region:              types.StringValue(sink.GetSpec().GetGcs().GetRegion()),
-			ServiceAccountEmail: types.StringValue(saEmail),
-		}
+	gcsObj, d := internaltypes.GCSSpecToObject(ctx, sink.GetSpec().GetGcs())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
 
-		gcsObj, diags = types.ObjectValueFrom(ctx, internaltypes.GcsSpecModelAttrTypes, gcsSpec)
-		diags.Append(diags...)
-		if diags.HasError() {
-			return diags
-		}
+	sinkStatus, d := exportSinkStatusFromProto(sink)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
 
 	state.SinkName = types.StringValue(sink.GetName())
@@ -312,10 +317,59 @@ func updateSinkModelFromSpec(ctx context.Context, state *namespaceExportSinkReso
 	state.Gcs = gcsObj
 	state.Namespace = types.StringValue(namespace)
 	state.ID = types.StringValue(fmt.Sprintf("%s,%s", namespace, sink.GetName()))
+	state.State = sinkStatus.State
+	state.Health = sinkStatus.Health
+	state.ErrorMessage = sinkStatus.ErrorMessage
+	state.LastSucceededTime = sinkStatus.LastSucceededTime
+	state.LastHealthCheckTime = sinkStatus.LastHealthCheckTime
 
 	return diags
 }
 
+// exportSinkStatus holds the server-reported status of an export sink, which
+// is shared between the resource and the data source.
+type exportSinkStatus struct {
+	State               types.String
+	Health              types.String
+	ErrorMessage        types.String
+	LastSucceededTime   types.String
+	LastHealthCheckTime types.String
+}
+
+func exportSinkStatusFromProto(sink *namespacev1.ExportSink) (*exportSinkStatus, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stateStr, err := enums.FromResourceState(sink.GetState())
+	if err != nil {
+		diags.AddError("Failed to convert export sink state", err.Error())
+		return nil, diags
+	}
+
+	healthStr, err := enums.FromExportSinkHealth(sink.GetHealth())
+	if err != nil {
+		diags.AddError("Failed to convert export sink health", err.Error())
+		return nil, diags
+	}
+
+	sinkStatus := &exportSinkStatus{
+		State:               types.StringValue(stateStr),
+		Health:              types.StringValue(healthStr),
+		ErrorMessage:        types.StringValue(sink.GetErrorMessage()),
+		LastSucceededTime:   types.StringNull(),
+		LastHealthCheckTime: types.StringNull(),
+	}
+
+	if sink.GetLatestDataExportTime() != nil {
+		sinkStatus.LastSucceededTime = types.StringValue(sink.GetLatestDataExportTime().AsTime().Format(time.RFC3339))
+	}
+
+	if sink.GetLastHealthCheckTime() != nil {
+		sinkStatus.LastHealthCheckTime = types.StringValue(sink.GetLastHealthCheckTime().AsTime().Format(time.RFC3339))
+	}
+
+	return sinkStatus, diags
+}
+
 func (r *namespaceExportSinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan namespaceExportSinkResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -383,22 +437,6 @@ func (r *namespaceExportSinkResource) ImportState(ctx context.Context, req resou
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func parseSAPrincipal(saPrincipal string) (string, string) {
-	var gcpProjectId, saId string
-	saPrincipalPattern := regexp.MustCompile(`^(\S+)@(\S+).iam.gserviceaccount.com$`)
-
-	submatch := saPrincipalPattern.FindStringSubmatch(saPrincipal)
-
-	if len(submatch) != 3 {
-		return "", ""
-	}
-
-	saId = submatch[1]
-	gcpProjectId = submatch[2]
-
-	return saId, gcpProjectId
-}
-
 func getSinkSpecFromModel(ctx context.Context, plan *namespaceExportSinkResourceModel) (*namespacev1.ExportSinkSpec, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -408,65 +446,28 @@ func getSinkSpecFromModel(ctx context.Context, plan *namespaceExportSinkResource
 		return nil, diags
 	}
 
-	if !plan.S3.IsNull() {
-		var s3Spec internaltypes.S3SpecModel
-		diags.Append(plan.S3.As(ctx, &s3Spec, basetypes.ObjectAsOptions{})...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		s3SinkSpec := &sinkv1.S3Spec{
-			RoleName:     s3Spec.RoleName.ValueString(),
-			BucketName:   s3Spec.BucketName.ValueString(),
-			Region:       s3Spec.Region.ValueString(),
-			KmsArn:       s3Spec.KmsArn.ValueString(),
-			AwsAccountId: s3Spec.AwsAccountId.ValueString(),
-		}
-
-		if !s3Spec.KmsArn.IsNull() {
-			s3SinkSpec.KmsArn = s3Spec.KmsArn.ValueString()
-		}
-
-		return &namespacev1.ExportSinkSpec{
-			Name:    plan.SinkName.ValueString(),
-			Enabled: plan.Enabled.ValueBool(),
-			S3:      s3SinkSpec,
-		}, nil
-	} else if !plan.Gcs.IsNull() {
-		var gcsSpec internaltypes.GCSSpecModel
-		diags.Append(plan.Gcs.As(ctx, &gcsSpec, basetypes.ObjectAsOptions{})...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		saId := gcsSpec.SaId.ValueString()
-		gcpProjectId := gcsSpec.GcpProjectId.ValueString()
-		if saId == "" && gcpProjectId == "" && gcsSpec.ServiceAccountEmail.ValueString() != "" {
-			saId, gcpProjectId = parseSAPrincipal(gcsSpec.ServiceAccountEmail.ValueString())
-
-		}
+	s3Spec, d := internaltypes.S3SpecFromObject(ctx, plan.S3)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-		if saId == "" || gcpProjectId == "" {
-			diags.AddError(
-				"Missing Service Account Configuration",
-				"Either provide both service_account_id and gcp_project_id, or provide a valid service_account_email",
-			)
-			return nil, diags
-		}
+	gcsSpec, d := internaltypes.GCSSpecFromObject(ctx, plan.Gcs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-		return &namespacev1.ExportSinkSpec{
-			Name:    plan.SinkName.ValueString(),
-			Enabled: plan.Enabled.ValueBool(),
-			Gcs: &sinkv1.GCSSpec{
-				SaId:         saId,
-				BucketName:   gcsSpec.BucketName.ValueString(),
-				GcpProjectId: gcpProjectId,
-				Region:       gcsSpec.Region.ValueString(),
-			},
-		}, nil
+	if s3Spec == nil && gcsSpec == nil {
+		return nil, diags
 	}
 
-	return nil, diags
+	return &namespacev1.ExportSinkSpec{
+		Name:    plan.SinkName.ValueString(),
+		Enabled: plan.Enabled.ValueBool(),
+		S3:      s3Spec,
+		Gcs:     gcsSpec,
+	}, diags
 }
 
 func (r *namespaceExportSinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
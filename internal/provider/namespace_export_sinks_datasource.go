@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	namespacev1 "go.temporal.io/cloud-sdk/api/namespace/v1"
+)
+
+type (
+	namespaceExportSinksDataSource struct {
+		client *client.Client
+	}
+
+	namespaceExportSinksDataModel struct {
+		ID        types.String                   `tfsdk:"id"`
+		Namespace types.String                   `tfsdk:"namespace"`
+		Sinks     []namespaceExportSinkDataModel `tfsdk:"sinks"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*namespaceExportSinksDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*namespaceExportSinksDataSource)(nil)
+)
+
+func NewNamespaceExportSinksDataSource() datasource.DataSource {
+	return &namespaceExportSinksDataSource{}
+}
+
+func (d *namespaceExportSinksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_export_sinks"
+}
+
+func (d *namespaceExportSinksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *namespaceExportSinksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about all export sinks of a Namespace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the Namespace Export Sinks data source.",
+				Computed:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace whose export sinks are listed, formatted as `<namespace>.<account_id>`.",
+				Required:    true,
+			},
+			"sinks": schema.ListNestedAttribute{
+				Description: "The list of export sinks configured on the namespace.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: namespaceExportSinkDataSourceSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *namespaceExportSinksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state namespaceExportSinksDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.Namespace.ValueString()) == 0 {
+		resp.Diagnostics.AddError("invalid namespace export sinks namespace", "namespace export sinks namespace is required")
+		return
+	}
+
+	var sinks []*namespacev1.ExportSink
+	pageToken := ""
+	for {
+		r, err := d.client.CloudService().GetNamespaceExportSinks(ctx, &cloudservicev1.GetNamespaceExportSinksRequest{
+			Namespace: state.Namespace.ValueString(),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch namespace export sinks", err.Error())
+			return
+		}
+
+		sinks = append(sinks, r.GetSinks()...)
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		pageToken = r.GetNextPageToken()
+	}
+
+	state.Sinks = make([]namespaceExportSinkDataModel, 0, len(sinks))
+	for _, sink := range sinks {
+		sinkModel, diags := exportSinkToNamespaceExportSinkDataModel(ctx, state.Namespace.ValueString(), sink)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Sinks = append(state.Sinks, *sinkModel)
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("namespace-%s-export-sinks", state.Namespace.ValueString()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
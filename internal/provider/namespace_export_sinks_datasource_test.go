@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSource_NamespaceExportSinks(t *testing.T) {
+	namespaceName := fmt.Sprintf("tf-test-ns-export-list-%s", randomString(8))
+	sinkRegion := "ca-central-1"
+	namespaceRegion := fmt.Sprintf("aws-%s", sinkRegion)
+	sinkName := fmt.Sprintf("tf-test-sink-%s", randomString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceExportSinksConfig(namespaceName, sinkName, namespaceRegion, sinkRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sinks.test", "sinks.#", "1"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sinks.test", "sinks.0.sink_name", sinkName),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sinks.test", "sinks.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.temporalcloud_namespace_export_sinks.test", "sinks.0.s3.region", sinkRegion),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_namespace_export_sinks.test", "sinks.0.health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespaceExportSinksConfig(namespaceName, sinkName, namespaceRegion, sinkRegion string) string {
+	return fmt.Sprintf(`
+provider "temporalcloud" {
+}
+
+resource "temporalcloud_namespace" "terraform" {
+  name           = %[1]q
+  regions        = [%[2]q]
+  api_key_auth   = true
+  retention_days = 1
+}
+
+resource "temporalcloud_namespace_export_sink" "test" {
+  namespace = temporalcloud_namespace.terraform.id
+  sink_name = %[3]q
+  enabled   = true
+  s3 = {
+    bucket_name    = "cloud-cicd-export-prod-cacentral1"
+    region         = %[4]q
+    role_name      = "cloud-cicd-export-external-trust-prod-cacentral1"
+    aws_account_id = "471170916252"
+  }
+}
+
+data "temporalcloud_namespace_export_sinks" "test" {
+  namespace = temporalcloud_namespace_export_sink.test.namespace
+}
+`, namespaceName, namespaceRegion, sinkName, sinkRegion)
+}
@@ -29,6 +29,8 @@ import (
 	"regexp"
 	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -393,8 +395,16 @@ func (r *namespaceResource) Schema(ctx context.Context, _ resource.SchemaRequest
 
 // ModifyPlan validates configured regions against the Temporal Cloud API.
 func (r *namespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Skip on destroy.
+	// Skip on destroy, apart from warning about namespace-scoped service accounts of the namespace.
 	if req.Plan.Raw.IsNull() {
+		if r.client == nil {
+			return
+		}
+		var id types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.namespaceScopedServiceAccountsWarning(ctx, id.ValueString())...)
+		}
 		return
 	}
 
@@ -441,6 +451,23 @@ func (r *namespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 			)
 			return
 		}
+
+		// Validate capacity changes against the options the API reports for this namespace, so an
+		// unsupported mode or value is rejected at plan time rather than part-way through apply.
+		if !plan.Capacity.IsNull() && !plan.Capacity.IsUnknown() && !plan.Capacity.Equal(state.Capacity.ObjectValue) && !state.ID.IsNull() {
+			var capacity capacityModel
+			resp.Diagnostics.Append(plan.Capacity.As(ctx, &capacity, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			getCapacityInfoFn := func(ctx context.Context, capacityReq *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return r.client.CloudService().GetNamespaceCapacityInfo(ctx, capacityReq)
+			}
+			resp.Diagnostics.Append(validateCapacityWithConfig(ctx, state.ID.ValueString(), capacity, getCapacityInfoFn)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
 	// Skip if regions are unknown (computed values not yet resolved).
@@ -479,6 +506,30 @@ func (r *namespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	resp.Diagnostics.Append(validateRegionsWithConfig(ctx, stateRegions, configuredRegions, getRegionsFn)...)
 }
 
+// namespaceScopedServiceAccountsWarning warns if the namespace planned for deletion has namespace-scoped
+// service accounts, which lose all access once it is deleted.
+func (r *namespaceResource) namespaceScopedServiceAccountsWarning(ctx context.Context, namespaceID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names, err := findNamespaceScopedServiceAccounts(ctx, r.client, namespaceID)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list namespace-scoped service accounts of the namespace planned for deletion", map[string]any{
+			"id":    namespaceID,
+			"error": err.Error(),
+		})
+		return diags
+	}
+	if len(names) == 0 {
+		return diags
+	}
+
+	diags.AddWarning(
+		"Namespace of namespace-scoped service account planned for deletion",
+		fmt.Sprintf("Namespace `%s` is planned for deletion, but namespace-scoped service accounts %s are scoped to it. They lose all access once the namespace is deleted.", namespaceID, strings.Join(names, ", ")),
+	)
+	return diags
+}
+
 // validateRegionsWithConfig checks that every region in configuredRegions is valid by calling the
 // getRegionsFn.
 //
@@ -517,6 +568,81 @@ func validateRegionsWithConfig(
 	return diags
 }
 
+// validateCapacityWithConfig checks the configured capacity against the capacity options reported
+// by getCapacityInfoFn for the namespace.
+//
+// Failures to fetch the capacity options only produce a warning, so that Terraform operations are not
+// blocked when the API is unavailable.
+func validateCapacityWithConfig(
+	ctx context.Context,
+	namespaceID string,
+	capacity capacityModel,
+	getCapacityInfoFn func(context.Context, *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if capacity.Mode.IsUnknown() || capacity.Value.IsUnknown() {
+		return diags
+	}
+
+	mode := capacity.Mode.ValueString()
+	if mode != "provisioned" && mode != "on_demand" {
+		diags.AddError("Invalid capacity mode", fmt.Sprintf("Invalid capacity mode %q, must be one of 'provisioned' or 'on_demand'", mode))
+		return diags
+	}
+
+	capacityResp, err := getCapacityInfoFn(ctx, &cloudservicev1.GetNamespaceCapacityInfoRequest{
+		Namespace: namespaceID,
+	})
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Validate Capacity",
+			fmt.Sprintf("Failed to fetch capacity information from Temporal Cloud API: %s. Capacity validation will be skipped.", err.Error()),
+		)
+		return diags
+	}
+	info := capacityResp.GetCapacityInfo()
+
+	if isCapacityChangeInProgress(info) {
+		diags.AddWarning(
+			"Capacity Change In Progress",
+			fmt.Sprintf("A capacity change for namespace %q is still in progress. Applying another capacity change may fail until it completes.", namespaceID),
+		)
+	}
+
+	if mode != "provisioned" {
+		return diags
+	}
+
+	options := info.GetModeOptions().GetProvisioned()
+	if options == nil {
+		diags.AddError(
+			"Invalid capacity mode",
+			fmt.Sprintf("Provisioned capacity is not available for namespace %q.", namespaceID),
+		)
+		return diags
+	}
+
+	value := capacity.Value.ValueFloat64()
+	if maxValue := options.GetMaxAvailableTruValue(); maxValue > 0 && value > maxValue {
+		diags.AddError(
+			"Invalid capacity value",
+			fmt.Sprintf("Capacity value %v exceeds the maximum available value %v for namespace %q.", value, maxValue, namespaceID),
+		)
+		return diags
+	}
+	if validValues := options.GetValidTruValues(); len(validValues) > 0 && !slices.Contains(validValues, value) {
+		allowed := make([]string, 0, len(validValues))
+		for _, v := range validValues {
+			allowed = append(allowed, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		diags.AddError(
+			"Invalid capacity value",
+			fmt.Sprintf("Capacity value %v is not allowed for namespace %q. Must be one of: %s.", value, namespaceID, strings.Join(allowed, ", ")),
+		)
+	}
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *namespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan namespaceResourceModel
@@ -355,6 +355,137 @@ func TestValidateRegionsWithConfig(t *testing.T) {
 	}
 }
 
+func TestValidateCapacityWithConfig(t *testing.T) {
+	t.Parallel()
+
+	capacityInfo := func(state namespace.Capacity_Request_State) *cloudservicev1.GetNamespaceCapacityInfoResponse {
+		return &cloudservicev1.GetNamespaceCapacityInfoResponse{
+			CapacityInfo: &namespace.NamespaceCapacityInfo{
+				CurrentCapacity: &namespace.Capacity{
+					LatestRequest: &namespace.Capacity_Request{State: state},
+				},
+				ModeOptions: &namespace.NamespaceCapacityInfo_CapacityModeOptions{
+					Provisioned: &namespace.NamespaceCapacityInfo_CapacityModeOptions_Provisioned{
+						ValidTruValues:       []float64{2, 4, 8},
+						MaxAvailableTruValue: 4,
+					},
+					OnDemand: &namespace.NamespaceCapacityInfo_CapacityModeOptions_OnDemand{ApsLimit: 500},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		mode              string
+		value             types.Float64
+		getCapacityInfoFn func(context.Context, *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error)
+		wantAPICall       bool
+		wantError         bool
+		wantWarning       bool
+	}{
+		{
+			name:  "invalid mode produces error without API call",
+			mode:  "burst",
+			value: types.Float64Null(),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return nil, errors.New("should not be called")
+			},
+			wantAPICall: false,
+			wantError:   true,
+		},
+		{
+			name:  "API error produces warning, not error",
+			mode:  "provisioned",
+			value: types.Float64Value(2),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return nil, errors.New("connection refused")
+			},
+			wantAPICall: true,
+			wantWarning: true,
+		},
+		{
+			name:  "on demand passes validation",
+			mode:  "on_demand",
+			value: types.Float64Null(),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return capacityInfo(namespace.Capacity_Request_STATE_CAPACITY_REQUEST_COMPLETED), nil
+			},
+			wantAPICall: true,
+		},
+		{
+			name:  "valid provisioned value passes validation",
+			mode:  "provisioned",
+			value: types.Float64Value(4),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return capacityInfo(namespace.Capacity_Request_STATE_CAPACITY_REQUEST_COMPLETED), nil
+			},
+			wantAPICall: true,
+		},
+		{
+			name:  "provisioned value not in valid values produces error",
+			mode:  "provisioned",
+			value: types.Float64Value(3),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return capacityInfo(namespace.Capacity_Request_STATE_CAPACITY_REQUEST_COMPLETED), nil
+			},
+			wantAPICall: true,
+			wantError:   true,
+		},
+		{
+			name:  "provisioned value above maximum produces error",
+			mode:  "provisioned",
+			value: types.Float64Value(8),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return capacityInfo(namespace.Capacity_Request_STATE_CAPACITY_REQUEST_COMPLETED), nil
+			},
+			wantAPICall: true,
+			wantError:   true,
+		},
+		{
+			name:  "in progress capacity change produces warning",
+			mode:  "provisioned",
+			value: types.Float64Value(2),
+			getCapacityInfoFn: func(_ context.Context, _ *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				return capacityInfo(namespace.Capacity_Request_STATE_CAPACITY_REQUEST_IN_PROGRESS), nil
+			},
+			wantAPICall: true,
+			wantWarning: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			wrappedFn := func(ctx context.Context, req *cloudservicev1.GetNamespaceCapacityInfoRequest) (*cloudservicev1.GetNamespaceCapacityInfoResponse, error) {
+				called = true
+				return tc.getCapacityInfoFn(ctx, req)
+			}
+
+			capacity := capacityModel{
+				Mode:  types.StringValue(tc.mode),
+				Value: tc.value,
+			}
+			diags := validateCapacityWithConfig(context.Background(), "ns.account", capacity, wrappedFn)
+
+			if called != tc.wantAPICall {
+				t.Errorf("API called = %v, want %v", called, tc.wantAPICall)
+			}
+			if tc.wantError && !diags.HasError() {
+				t.Error("expected error diagnostic, got none")
+			}
+			if !tc.wantError && diags.HasError() {
+				t.Errorf("unexpected error diagnostics: %+v", diags)
+			}
+			if tc.wantWarning && len(diags) == 0 {
+				t.Error("expected warning diagnostic, got none")
+			}
+		})
+	}
+}
+
 func TestAccBasicNamespaceWithApiKeyAuth(t *testing.T) {
 	name := fmt.Sprintf("%s-%s", "tf-basic-namespace", randomString(10))
 	config := func(name string, retention int) string {
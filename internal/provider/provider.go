@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +18,10 @@ import (
 )
 
 // Ensure TerraformCloudProvider satisfies various provider interfaces.
-var _ provider.Provider = &TerraformCloudProvider{}
+var (
+	_ provider.Provider                       = &TerraformCloudProvider{}
+	_ provider.ProviderWithEphemeralResources = &TerraformCloudProvider{}
+)
 
 // TerraformCloudProvider defines the provider implementation.
 type TerraformCloudProvider struct {
@@ -162,6 +166,7 @@ func (p *TerraformCloudProvider) Configure(ctx context.Context, req provider.Con
 
 	resp.DataSourceData = cc
 	resp.ResourceData = cc
+	resp.EphemeralResourceData = cc
 }
 
 func (p *TerraformCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -173,12 +178,16 @@ func (p *TerraformCloudProvider) Resources(ctx context.Context) []func() resourc
 		NewUserResource,
 		NewServiceAccountResource,
 		NewApiKeyResource,
+		NewApiKeyRotationResource,
 		NewMetricsEndpointResource,
 		NewNexusEndpointResource,
 		NewNamespaceExportSinkResource,
 		NewUserGroupResource,
 		NewUserGroupMembersResource,
+		NewUserGroupMemberResource,
 		NewGroupAccessResource,
+		NewNamespaceAccessResource,
+		NewUsersRosterResource,
 		NewConnectivityRuleResource,
 		NewAccountAuditLogSinkResource,
 	}
@@ -198,6 +207,24 @@ func (p *TerraformCloudProvider) DataSources(ctx context.Context) []func() datas
 		NewNexusEndpointsDataSource,
 		NewConnectivityRuleDataSource,
 		NewAccountAuditLogSinkDataSource,
+		NewNamespaceExportSinkDataSource,
+		NewNamespaceExportSinksDataSource,
+		NewAccountAuditLogSinksDataSource,
+		NewUsageDataSource,
+		NewNamespaceCapacityInfoDataSource,
+		NewApiKeysDataSource,
+		NewCustomRoleDataSource,
+		NewCustomRolesDataSource,
+		NewEffectiveAccessDataSource,
+		NewUserGroupDataSource,
+		NewUserGroupsDataSource,
+	}
+}
+
+func (p *TerraformCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
+		NewApiKeyTokenEphemeralResource,
 	}
 }
 
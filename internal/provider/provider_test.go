@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	"google.golang.org/grpc"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -24,6 +29,34 @@ func testAccPreCheck(t *testing.T) {
 	// function.
 }
 
+// newTestClient serves srv as an in-process Temporal Cloud API and returns a client connected to it, to
+// unit test the handling of API responses and failures.
+func newTestClient(t *testing.T, srv cloudservicev1.CloudServiceServer) *client.Client {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	server := grpc.NewServer()
+	cloudservicev1.RegisterCloudServiceServer(server, srv)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	c, err := client.NewConnectionWithAPIKey(lis.Addr().String(), true, "test-api-key", "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	return c
+}
+
 func TestProviderSchema(t *testing.T) {
 	t.Parallel()
 
@@ -32,7 +32,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
-	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	"go.temporal.io/cloud-sdk/api/identity/v1"
 )
 
@@ -90,13 +89,7 @@ func (d *scimGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// Need to get group by idp ID
-	// Get user groups, filter by idp ID
-	groupResp, err := d.client.CloudService().GetUserGroups(ctx, &cloudservicev1.GetUserGroupsRequest{
-		ScimGroup: &cloudservicev1.GetUserGroupsRequest_SCIMGroupFilter{
-			IdpId: input.IdpId.ValueString(),
-		},
-	})
+	group, err := findSCIMGroupByIdpID(ctx, d.client, input.IdpId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read SCIM group",
@@ -104,22 +97,13 @@ func (d *scimGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		)
 		return
 	}
-
-	if len(groupResp.Groups) == 0 {
+	if group == nil {
 		resp.Diagnostics.AddError(
 			"SCIM group not found",
 			fmt.Sprintf("SCIM group %s not found", input.IdpId.ValueString()),
 		)
 		return
 	}
-	if len(groupResp.Groups) > 1 {
-		resp.Diagnostics.AddError(
-			"Multiple SCIM groups found",
-			fmt.Sprintf("Multiple SCIM groups found for %s", input.IdpId.ValueString()),
-		)
-		return
-	}
-	group := groupResp.Groups[0]
 
 	scimGroupDataModel, diags := scimGroupToDataModel(ctx, group)
 	resp.Diagnostics.Append(diags...)
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 )
 
 type (
@@ -46,7 +49,7 @@ func (d *serviceAccountDataSource) Configure(ctx context.Context, req datasource
 
 func (d *serviceAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches details about a Service Account.",
+		Description: "Fetches details about a Service Account, by ID or by name.",
 		Attributes:  serviceAccountSchema(true),
 	}
 }
@@ -58,20 +61,30 @@ func (d *serviceAccountDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	if len(input.ID.ValueString()) == 0 {
-		resp.Diagnostics.AddError("invalid service account id", "service account id is required")
-		return
-	}
-
-	saResp, err := d.client.CloudService().GetServiceAccount(ctx, &cloudservicev1.GetServiceAccountRequest{
-		ServiceAccountId: input.ID.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to fetch service account", err.Error())
-		return
+	var serviceAccount *identityv1.ServiceAccount
+	if !input.ID.IsNull() {
+		saResp, err := d.client.CloudService().GetServiceAccount(ctx, &cloudservicev1.GetServiceAccountRequest{
+			ServiceAccountId: input.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch service account", err.Error())
+			return
+		}
+		serviceAccount = saResp.GetServiceAccount()
+	} else {
+		var err error
+		serviceAccount, err = findServiceAccountByName(ctx, d.client, input.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch service account", err.Error())
+			return
+		}
+		if serviceAccount == nil {
+			resp.Diagnostics.AddError("Service account not found", fmt.Sprintf("No service account named %q found.", input.Name.ValueString()))
+			return
+		}
 	}
 
-	saDataModel, diags := serviceAccountToServiceAccountDataModel(ctx, saResp.GetServiceAccount())
+	saDataModel, diags := serviceAccountToServiceAccountDataModel(ctx, serviceAccount)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -197,3 +197,37 @@ output "service_account" {
 		},
 	})
 }
+
+func TestAccDataSource_ServiceAccountByName(t *testing.T) {
+	name := createRandomName()
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_service_account" "terraform" {
+  name           = "%s"
+  account_access = "read"
+}
+
+data "temporalcloud_service_account" "terraform" {
+  name = temporalcloud_service_account.terraform.name
+}
+`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.temporalcloud_service_account.terraform", "id", "temporalcloud_service_account.terraform", "id"),
+					resource.TestCheckResourceAttr("data.temporalcloud_service_account.terraform", "account_access", "read"),
+				),
+			},
+		},
+	})
+}
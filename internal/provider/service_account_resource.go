@@ -61,6 +61,7 @@ var (
 	_ resource.Resource                = (*serviceAccountResource)(nil)
 	_ resource.ResourceWithConfigure   = (*serviceAccountResource)(nil)
 	_ resource.ResourceWithImportState = (*serviceAccountResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*serviceAccountResource)(nil)
 
 	serviceAccountNamespaceAccessAttrs = map[string]attr.Type{
 		"namespace_id": types.StringType,
@@ -176,7 +177,7 @@ func (r *serviceAccountResource) Schema(ctx context.Context, _ resource.SchemaRe
 				},
 			},
 			"namespace_scoped_access": schema.SingleNestedAttribute{
-				Description: "Configures this service account as a namespace-scoped service account with access to only a single namespace. The namespace assignment is immutable after creation. Cannot be set if account_access, account_access_custom_roles, or namespace_accesses are provided.",
+				Description: "Configures this service account as a namespace-scoped service account with access to only a single namespace. The namespace assignment is immutable after creation. Cannot be set if account_access, account_access_custom_roles, or namespace_accesses are provided. Converting between an account-scoped and a namespace-scoped service account replaces the service account.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"namespace_id": schema.StringAttribute{
@@ -212,6 +213,37 @@ func (r *serviceAccountResource) Schema(ctx context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *serviceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan serviceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateServiceAccountHasAccess(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !plan.NamespaceScopedAccess.IsUnknown() {
+		var state serviceAccountResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The scope of a service account cannot change in place.
+		if state.NamespaceScopedAccess.IsNull() != plan.NamespaceScopedAccess.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("namespace_scoped_access"))
+		}
+	}
+}
+
 func (r *serviceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan serviceAccountResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -488,6 +520,26 @@ func buildServiceAccountSpec(ctx context.Context, plan *serviceAccountResourceMo
 	return spec, diags
 }
 
+// validateServiceAccountHasAccess checks that the service account is either account-scoped or namespace-scoped.
+// Setting both is rejected by the ConflictsWith validators of the schema.
+func validateServiceAccountHasAccess(plan *serviceAccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.AccountAccess.IsUnknown() || plan.NamespaceScopedAccess.IsUnknown() {
+		return diags
+	}
+
+	if plan.NamespaceScopedAccess.IsNull() && plan.AccountAccess.IsNull() {
+		diags.AddAttributeError(
+			path.Root("account_access"),
+			"Missing access configuration",
+			"Either account_access must be set for an account-scoped service account, or namespace_scoped_access for a namespace-scoped service account.",
+		)
+	}
+
+	return diags
+}
+
 func getNamespaceScopedAccessFromModel(ctx context.Context, model *serviceAccountResourceModel) (*identityv1.NamespaceScopedAccess, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var namespaceScopedAccessModel serviceAccountNamespaceAccessModel
@@ -7,16 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"text/template"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
 )
 
 func TestServiceAccountSchema(t *testing.T) {
@@ -41,6 +46,112 @@ func TestServiceAccountSchema(t *testing.T) {
 	}
 }
 
+func TestValidateServiceAccountHasAccess(t *testing.T) {
+	t.Parallel()
+
+	namespaceScopedAccess := types.ObjectValueMust(serviceAccountNamespaceAccessAttrs, map[string]attr.Value{
+		"namespace_id": types.StringValue("ns.account"),
+		"permission":   internaltypes.CaseInsensitiveString("write"),
+	})
+	namespaceAccesses := types.SetValueMust(types.ObjectType{AttrTypes: serviceAccountNamespaceAccessAttrs}, []attr.Value{namespaceScopedAccess})
+
+	tests := []struct {
+		name      string
+		plan      serviceAccountResourceModel
+		wantError string
+	}{
+		{
+			name: "account-scoped",
+			plan: serviceAccountResourceModel{
+				AccountAccess:         internaltypes.CaseInsensitiveString("read"),
+				NamespaceAccesses:     namespaceAccesses,
+				NamespaceScopedAccess: types.ObjectNull(serviceAccountNamespaceAccessAttrs),
+			},
+		},
+		{
+			name: "namespace-scoped",
+			plan: serviceAccountResourceModel{
+				AccountAccessCustomRoles: types.SetNull(types.StringType),
+				NamespaceAccesses:        types.SetNull(types.ObjectType{AttrTypes: serviceAccountNamespaceAccessAttrs}),
+				NamespaceScopedAccess:    namespaceScopedAccess,
+			},
+		},
+		{
+			name: "neither",
+			plan: serviceAccountResourceModel{
+				NamespaceScopedAccess: types.ObjectNull(serviceAccountNamespaceAccessAttrs),
+			},
+			wantError: "Missing access configuration",
+		},
+		{
+			name: "namespace accesses without account access",
+			plan: serviceAccountResourceModel{
+				NamespaceAccesses:     namespaceAccesses,
+				NamespaceScopedAccess: types.ObjectNull(serviceAccountNamespaceAccessAttrs),
+			},
+			wantError: "Missing access configuration",
+		},
+		{
+			name: "unknown namespace-scoped access",
+			plan: serviceAccountResourceModel{
+				NamespaceScopedAccess: types.ObjectUnknown(serviceAccountNamespaceAccessAttrs),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateServiceAccountHasAccess(&tt.plan)
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %+v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+				t.Fatalf("expected error %q, got %+v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+// fakeServiceAccountsCloudService serves the given service accounts.
+type fakeServiceAccountsCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	serviceAccounts []*identityv1.ServiceAccount
+}
+
+func (f *fakeServiceAccountsCloudService) GetServiceAccounts(_ context.Context, _ *cloudservicev1.GetServiceAccountsRequest) (*cloudservicev1.GetServiceAccountsResponse, error) {
+	return &cloudservicev1.GetServiceAccountsResponse{ServiceAccount: f.serviceAccounts}, nil
+}
+
+func TestNamespaceScopedServiceAccountsWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	namespaceScoped := func(name, namespaceID string) *identityv1.ServiceAccount {
+		return &identityv1.ServiceAccount{Spec: &identityv1.ServiceAccountSpec{
+			Name:                  name,
+			NamespaceScopedAccess: &identityv1.NamespaceScopedAccess{Namespace: namespaceID},
+		}}
+	}
+	r := &namespaceResource{client: newTestClient(t, &fakeServiceAccountsCloudService{serviceAccounts: []*identityv1.ServiceAccount{
+		namespaceScoped("sa2", "ns1.account"),
+		namespaceScoped("sa1", "ns1.account"),
+		namespaceScoped("sa3", "ns2.account"),
+		{Spec: &identityv1.ServiceAccountSpec{Name: "account-scoped"}},
+	}})}
+
+	if diags := r.namespaceScopedServiceAccountsWarning(ctx, "ns3.account"); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics for a namespace without scoped service accounts: %+v", diags)
+	}
+	diags := r.namespaceScopedServiceAccountsWarning(ctx, "ns1.account")
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "service accounts sa1, sa2 are") {
+		t.Errorf("expected a warning listing sa1 and sa2, got %+v", diags)
+	}
+}
+
 func createRandomName() string {
 	return fmt.Sprintf("%s-terraformprovider-name", randomString(10))
 }
@@ -564,6 +675,62 @@ resource "temporalcloud_service_account" "terraform" {
 	})
 }
 
+func TestAccServiceAccountConvertToNamespaceScoped(t *testing.T) {
+	name := createRandomName()
+	namespaceName := randomString(10)
+
+	namespaceConfig := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_namespace" "test" {
+  name               = "%s"
+  regions            = ["aws-ca-central-1"]
+  api_key_auth       = true
+  retention_days     = 7
+}
+`, namespaceName)
+
+	var accountScopedID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: namespaceConfig + fmt.Sprintf(`
+resource "temporalcloud_service_account" "terraform" {
+  name           = "%s"
+  account_access = "read"
+}`, name),
+				Check: func(state *terraform.State) error {
+					accountScopedID = state.RootModule().Resources["temporalcloud_service_account.terraform"].Primary.Attributes["id"]
+					return nil
+				},
+			},
+			{
+				Config: namespaceConfig + fmt.Sprintf(`
+resource "temporalcloud_service_account" "terraform" {
+  name = "%s"
+  namespace_scoped_access = {
+    namespace_id = temporalcloud_namespace.test.id
+    permission   = "read"
+  }
+}`, name),
+				Check: func(state *terraform.State) error {
+					id := state.RootModule().Resources["temporalcloud_service_account.terraform"].Primary.Attributes["id"]
+					if id == accountScopedID {
+						return errors.New("expected the service account to be replaced when converting to namespace-scoped")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccBasicServiceAccountOrderingNamespaceAccesses(t *testing.T) {
 	type configArgs struct {
 		Name               string
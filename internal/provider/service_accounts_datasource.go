@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
@@ -23,9 +28,24 @@ type (
 
 	serviceAccountsDataModel struct {
 		ID              types.String              `tfsdk:"id"`
+		NameRegex       types.String              `tfsdk:"name_regex"`
+		AccountAccess   types.String              `tfsdk:"account_access"`
+		NamespaceID     types.String              `tfsdk:"namespace_id"`
+		State           types.String              `tfsdk:"state"`
+		NamespaceScoped types.Bool                `tfsdk:"namespace_scoped"`
 		ServiceAccounts []serviceAccountDataModel `tfsdk:"service_accounts"`
 	}
 
+	// serviceAccountFilters holds the filters of the service accounts data source. Empty filters match every
+	// service account.
+	serviceAccountFilters struct {
+		nameRegex       *regexp.Regexp
+		accountAccess   string
+		namespaceID     string
+		state           string
+		namespaceScoped *bool
+	}
+
 	serviceAccountDataModel struct {
 		ID                       types.String                             `tfsdk:"id"`
 		Name                     types.String                             `tfsdk:"name"`
@@ -75,16 +95,23 @@ func (d *serviceAccountsDataSource) Configure(ctx context.Context, req datasourc
 	d.client = client
 }
 
-func serviceAccountSchema(idRequired bool) map[string]schema.Attribute {
+func serviceAccountSchema(lookup bool) map[string]schema.Attribute {
 	idAttribute := schema.StringAttribute{
 		Description: "The unique identifier of the Service Account.",
+		Computed:    true,
 	}
-
-	switch idRequired {
-	case true:
-		idAttribute.Required = true
-	case false:
-		idAttribute.Computed = true
+	nameAttribute := schema.StringAttribute{
+		Description: "The name associated with the service account.",
+		Computed:    true,
+	}
+	if lookup {
+		idAttribute.Description = "The unique identifier of the Service Account. Exactly one of id or name must be set."
+		idAttribute.Optional = true
+		idAttribute.Validators = []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		}
+		nameAttribute.Description = "The name associated with the service account. Exactly one of id or name must be set."
+		nameAttribute.Optional = true
 	}
 
 	return map[string]schema.Attribute{
@@ -97,10 +124,7 @@ func serviceAccountSchema(idRequired bool) map[string]schema.Attribute {
 			Description: "The current state of the Service Account.",
 			Computed:    true,
 		},
-		"name": schema.StringAttribute{
-			Description: "The name associated with the service account.",
-			Computed:    true,
-		},
+		"name": nameAttribute,
 		"account_access": schema.StringAttribute{
 			CustomType:  internaltypes.CaseInsensitiveStringType{},
 			Description: "The role on the account. Must be one of admin, developer, read, or metricsread (case-insensitive).",
@@ -157,12 +181,35 @@ func serviceAccountSchema(idRequired bool) map[string]schema.Attribute {
 
 func (d *serviceAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches details about all Service Accounts.",
+		Description: "Fetches details about all Service Accounts, optionally filtered.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the Service Accounts data source.",
 				Computed:    true,
 			},
+			"name_regex": schema.StringAttribute{
+				Description: "If set, only service accounts whose name matches this regular expression are returned.",
+				Optional:    true,
+			},
+			"account_access": schema.StringAttribute{
+				Description: "If set, only account-scoped service accounts with this role on the account are returned. Must be one of admin, developer, read, or metricsread (case-insensitive).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(enums.AllowedAccountAccessRoles()...),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Description: "If set, only service accounts with access to this namespace are returned, either through namespace_accesses or namespace_scoped_access.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "If set, only service accounts in this state are returned, such as active.",
+				Optional:    true,
+			},
+			"namespace_scoped": schema.BoolAttribute{
+				Description: "If set, only namespace-scoped service accounts (true) or only account-scoped service accounts (false) are returned.",
+				Optional:    true,
+			},
 			"service_accounts": schema.ListNestedAttribute{
 				Description: "The list of Service Accounts.",
 				Computed:    true,
@@ -176,6 +223,28 @@ func (d *serviceAccountsDataSource) Schema(ctx context.Context, req datasource.S
 
 func (d *serviceAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state serviceAccountsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := serviceAccountFilters{
+		accountAccess: state.AccountAccess.ValueString(),
+		namespaceID:   state.NamespaceID.ValueString(),
+		state:         state.State.ValueString(),
+	}
+	if !state.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+		filters.nameRegex = nameRegex
+	}
+	if !state.NamespaceScoped.IsNull() {
+		namespaceScoped := state.NamespaceScoped.ValueBool()
+		filters.namespaceScoped = &namespaceScoped
+	}
 
 	var serviceAccounts []*identityv1.ServiceAccount
 	pageToken := ""
@@ -195,7 +264,17 @@ func (d *serviceAccountsDataSource) Read(ctx context.Context, req datasource.Rea
 		pageToken = r.GetNextPageToken()
 	}
 
+	state.ServiceAccounts = make([]serviceAccountDataModel, 0, len(serviceAccounts))
 	for _, sa := range serviceAccounts {
+		matches, err := filters.matches(sa)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to filter service accounts", err.Error())
+			return
+		}
+		if !matches {
+			continue
+		}
+
 		serviceAccountModel, diags := serviceAccountToServiceAccountDataModel(ctx, sa)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -216,6 +295,48 @@ func (d *serviceAccountsDataSource) Read(ctx context.Context, req datasource.Rea
 	resp.Diagnostics.Append(diags...)
 }
 
+// matches reports whether the service account matches all of the filters.
+func (f *serviceAccountFilters) matches(sa *identityv1.ServiceAccount) (bool, error) {
+	spec := sa.GetSpec()
+	namespaceScopedAccess := spec.GetNamespaceScopedAccess()
+
+	if f.nameRegex != nil && !f.nameRegex.MatchString(spec.GetName()) {
+		return false, nil
+	}
+	if f.namespaceScoped != nil && *f.namespaceScoped != (namespaceScopedAccess != nil) {
+		return false, nil
+	}
+	if f.state != "" {
+		state, err := enums.FromResourceState(sa.GetState())
+		if err != nil {
+			return false, err
+		}
+		if !strings.EqualFold(state, f.state) {
+			return false, nil
+		}
+	}
+	if f.accountAccess != "" {
+		if namespaceScopedAccess != nil {
+			return false, nil
+		}
+		role, err := enums.FromAccountAccessRole(spec.GetAccess().GetAccountAccess().GetRole())
+		if err != nil {
+			return false, err
+		}
+		if !strings.EqualFold(role, f.accountAccess) {
+			return false, nil
+		}
+	}
+	if f.namespaceID != "" {
+		if namespaceScopedAccess != nil {
+			return namespaceScopedAccess.GetNamespace() == f.namespaceID, nil
+		}
+		_, ok := spec.GetAccess().GetNamespaceAccesses()[f.namespaceID]
+		return ok, nil
+	}
+	return true, nil
+}
+
 func serviceAccountToServiceAccountDataModel(ctx context.Context, sa *identityv1.ServiceAccount) (*serviceAccountDataModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	stateStr, err := enums.FromResourceState(sa.State)
@@ -4,11 +4,14 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"regexp"
 	"testing"
 	"text/template"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
 )
 
 func TestAccServiceAccounts(t *testing.T) {
@@ -176,3 +179,121 @@ output "service_accounts" {
 		},
 	})
 }
+
+func TestServiceAccountFiltersMatches(t *testing.T) {
+	t.Parallel()
+
+	accountScoped := &identityv1.ServiceAccount{
+		State: resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		Spec: &identityv1.ServiceAccountSpec{
+			Name: "ci-deployer",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_DEVELOPER},
+				NamespaceAccesses: map[string]*identityv1.NamespaceAccess{
+					"prod.account": {Permission: identityv1.NamespaceAccess_PERMISSION_WRITE},
+				},
+			},
+		},
+	}
+	namespaceScoped := &identityv1.ServiceAccount{
+		State: resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		Spec: &identityv1.ServiceAccountSpec{
+			Name: "ci-worker",
+			NamespaceScopedAccess: &identityv1.NamespaceScopedAccess{
+				Namespace: "prod.account",
+				Access:    &identityv1.NamespaceAccess{Permission: identityv1.NamespaceAccess_PERMISSION_WRITE},
+			},
+		},
+	}
+	yes, no := true, false
+
+	tests := []struct {
+		name           string
+		filters        serviceAccountFilters
+		serviceAccount *identityv1.ServiceAccount
+		want           bool
+	}{
+		{"no filters", serviceAccountFilters{}, accountScoped, true},
+		{"name regex match", serviceAccountFilters{nameRegex: regexp.MustCompile("^ci-")}, accountScoped, true},
+		{"name regex mismatch", serviceAccountFilters{nameRegex: regexp.MustCompile("^admin")}, accountScoped, false},
+		{"account access match", serviceAccountFilters{accountAccess: "Developer"}, accountScoped, true},
+		{"account access mismatch", serviceAccountFilters{accountAccess: "admin"}, accountScoped, false},
+		{"account access on namespace-scoped", serviceAccountFilters{accountAccess: "developer"}, namespaceScoped, false},
+		{"namespace access match", serviceAccountFilters{namespaceID: "prod.account"}, accountScoped, true},
+		{"namespace access mismatch", serviceAccountFilters{namespaceID: "dev.account"}, accountScoped, false},
+		{"namespace-scoped namespace match", serviceAccountFilters{namespaceID: "prod.account"}, namespaceScoped, true},
+		{"namespace-scoped namespace mismatch", serviceAccountFilters{namespaceID: "dev.account"}, namespaceScoped, false},
+		{"state match", serviceAccountFilters{state: "active"}, accountScoped, true},
+		{"state mismatch", serviceAccountFilters{state: "deleting"}, accountScoped, false},
+		{"namespace scoped", serviceAccountFilters{namespaceScoped: &yes}, namespaceScoped, true},
+		{"not namespace scoped", serviceAccountFilters{namespaceScoped: &no}, namespaceScoped, false},
+		{"all filters", serviceAccountFilters{nameRegex: regexp.MustCompile("worker"), namespaceID: "prod.account", state: "active", namespaceScoped: &yes}, namespaceScoped, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.matches(tt.serviceAccount)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccServiceAccountsFilters(t *testing.T) {
+	name := createRandomName()
+	namespaceName := randomString(10)
+
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_namespace" "test" {
+  name               = "%s"
+  regions            = ["aws-ca-central-1"]
+  api_key_auth       = true
+  retention_days     = 7
+}
+
+resource "temporalcloud_service_account" "namespace_scoped" {
+  name = "%s"
+  namespace_scoped_access = {
+    namespace_id = temporalcloud_namespace.test.id
+    permission   = "write"
+  }
+}
+
+data "temporalcloud_service_accounts" "filtered" {
+  name_regex       = "^${temporalcloud_service_account.namespace_scoped.name}$"
+  namespace_id     = temporalcloud_namespace.test.id
+  state            = "active"
+  namespace_scoped = true
+}
+
+data "temporalcloud_service_accounts" "account_scoped" {
+  name_regex     = "^${temporalcloud_service_account.namespace_scoped.name}$"
+  account_access = "read"
+}
+`, namespaceName, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_service_accounts.filtered", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.temporalcloud_service_accounts.filtered", "service_accounts.0.name", name),
+					resource.TestCheckResourceAttr("data.temporalcloud_service_accounts.account_scoped", "service_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
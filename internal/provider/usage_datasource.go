@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	usagev1 "go.temporal.io/cloud-sdk/api/usage/v1"
+)
+
+type (
+	usageDataSource struct {
+		client *client.Client
+	}
+
+	usageDataModel struct {
+		ID                              types.String            `tfsdk:"id"`
+		StartTime                       types.String            `tfsdk:"start_time"`
+		EndTime                         types.String            `tfsdk:"end_time"`
+		Namespaces                      types.Set               `tfsdk:"namespaces"`
+		Incomplete                      types.Bool              `tfsdk:"incomplete"`
+		TotalActions                    types.Float64           `tfsdk:"total_actions"`
+		TotalActiveStorageByteSeconds   types.Float64           `tfsdk:"total_active_storage_byte_seconds"`
+		TotalRetainedStorageByteSeconds types.Float64           `tfsdk:"total_retained_storage_byte_seconds"`
+		NamespaceUsages                 []namespaceUsageModel   `tfsdk:"namespace_usages"`
+		Summaries                       []usageSummaryDataModel `tfsdk:"summaries"`
+	}
+
+	namespaceUsageModel struct {
+		Namespace                  types.String  `tfsdk:"namespace"`
+		Actions                    types.Float64 `tfsdk:"actions"`
+		ActiveStorageByteSeconds   types.Float64 `tfsdk:"active_storage_byte_seconds"`
+		RetainedStorageByteSeconds types.Float64 `tfsdk:"retained_storage_byte_seconds"`
+	}
+
+	usageSummaryDataModel struct {
+		StartTime                  types.String          `tfsdk:"start_time"`
+		EndTime                    types.String          `tfsdk:"end_time"`
+		Incomplete                 types.Bool            `tfsdk:"incomplete"`
+		Actions                    types.Float64         `tfsdk:"actions"`
+		ActiveStorageByteSeconds   types.Float64         `tfsdk:"active_storage_byte_seconds"`
+		RetainedStorageByteSeconds types.Float64         `tfsdk:"retained_storage_byte_seconds"`
+		NamespaceUsages            []namespaceUsageModel `tfsdk:"namespace_usages"`
+	}
+
+	// usageTotals accumulates usage records for a namespace or a summary.
+	usageTotals struct {
+		actions         float64
+		activeStorage   float64
+		retainedStorage float64
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*usageDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*usageDataSource)(nil)
+)
+
+func NewUsageDataSource() datasource.DataSource {
+	return &usageDataSource{}
+}
+
+func (d *usageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *usageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func namespaceUsageSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"namespace": schema.StringAttribute{
+			Description: "The namespace the usage was recorded for.",
+			Computed:    true,
+		},
+		"actions": schema.Float64Attribute{
+			Description: "The number of actions recorded for the namespace.",
+			Computed:    true,
+		},
+		"active_storage_byte_seconds": schema.Float64Attribute{
+			Description: "The active storage recorded for the namespace, in byte-seconds.",
+			Computed:    true,
+		},
+		"retained_storage_byte_seconds": schema.Float64Attribute{
+			Description: "The retained storage recorded for the namespace, in byte-seconds.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *usageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the account usage (actions and storage) for a time range, aggregated per namespace and per day.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the Usage data source.",
+				Computed:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "The inclusive start of the time range, formatted as RFC3339. Must be midnight UTC and within the last 90 days. Defaults to the start of the current month.",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "The exclusive end of the time range, formatted as RFC3339. Must be midnight UTC and within the last 90 days. Defaults to the start of the next UTC day.",
+				Optional:    true,
+			},
+			"namespaces": schema.SetAttribute{
+				Description: "If set, only usage of these namespaces is returned and included in the totals.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"incomplete": schema.BoolAttribute{
+				Description: "True if the data for any day in the time range is not fully available yet and may still change.",
+				Computed:    true,
+			},
+			"total_actions": schema.Float64Attribute{
+				Description: "The total number of actions in the time range.",
+				Computed:    true,
+			},
+			"total_active_storage_byte_seconds": schema.Float64Attribute{
+				Description: "The total active storage in the time range, in byte-seconds.",
+				Computed:    true,
+			},
+			"total_retained_storage_byte_seconds": schema.Float64Attribute{
+				Description: "The total retained storage in the time range, in byte-seconds.",
+				Computed:    true,
+			},
+			"namespace_usages": schema.ListNestedAttribute{
+				Description: "The usage in the time range aggregated per namespace, ordered by namespace.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: namespaceUsageSchema(),
+				},
+			},
+			"summaries": schema.ListNestedAttribute{
+				Description: "The usage per day, ordered by time in ascending order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Description: "The inclusive start of the day, formatted as RFC3339.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "The exclusive end of the day, formatted as RFC3339.",
+							Computed:    true,
+						},
+						"incomplete": schema.BoolAttribute{
+							Description: "True if the data for this day is not fully available yet and may still change.",
+							Computed:    true,
+						},
+						"actions": schema.Float64Attribute{
+							Description: "The number of actions on this day.",
+							Computed:    true,
+						},
+						"active_storage_byte_seconds": schema.Float64Attribute{
+							Description: "The active storage on this day, in byte-seconds.",
+							Computed:    true,
+						},
+						"retained_storage_byte_seconds": schema.Float64Attribute{
+							Description: "The retained storage on this day, in byte-seconds.",
+							Computed:    true,
+						},
+						"namespace_usages": schema.ListNestedAttribute{
+							Description: "The usage on this day per namespace, ordered by namespace.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: namespaceUsageSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usageDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usageReq := &cloudservicev1.GetUsageRequest{}
+	if !state.StartTime.IsNull() {
+		startTime, err := time.Parse(time.RFC3339, state.StartTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid start_time", fmt.Sprintf("start_time must be formatted as RFC3339: %s", err.Error()))
+			return
+		}
+		usageReq.StartTimeInclusive = timestamppb.New(startTime)
+	}
+
+	if !state.EndTime.IsNull() {
+		endTime, err := time.Parse(time.RFC3339, state.EndTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid end_time", fmt.Sprintf("end_time must be formatted as RFC3339: %s", err.Error()))
+			return
+		}
+		usageReq.EndTimeExclusive = timestamppb.New(endTime)
+	}
+
+	var namespaceFilter map[string]bool
+	if !state.Namespaces.IsNull() {
+		var namespaces []string
+		resp.Diagnostics.Append(state.Namespaces.ElementsAs(ctx, &namespaces, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		namespaceFilter = make(map[string]bool, len(namespaces))
+		for _, ns := range namespaces {
+			namespaceFilter[ns] = true
+		}
+	}
+
+	var summaries []*usagev1.Summary
+	for {
+		r, err := d.client.CloudService().GetUsage(ctx, usageReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch usage", err.Error())
+			return
+		}
+
+		summaries = append(summaries, r.GetSummaries()...)
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		usageReq.PageToken = r.GetNextPageToken()
+	}
+
+	accountTotals := usageTotals{}
+	namespaceTotals := make(map[string]*usageTotals)
+	incomplete := false
+	summaries = mergeUsageSummaries(summaries)
+	state.Summaries = make([]usageSummaryDataModel, 0, len(summaries))
+	for _, summary := range summaries {
+		summaryTotals := usageTotals{}
+		summaryNamespaceTotals := make(map[string]*usageTotals)
+		for _, group := range summary.GetRecordGroups() {
+			namespace := usageRecordGroupNamespace(group)
+			if namespaceFilter != nil && !namespaceFilter[namespace] {
+				continue
+			}
+
+			if _, ok := namespaceTotals[namespace]; !ok {
+				namespaceTotals[namespace] = &usageTotals{}
+			}
+			if _, ok := summaryNamespaceTotals[namespace]; !ok {
+				summaryNamespaceTotals[namespace] = &usageTotals{}
+			}
+
+			for _, record := range group.GetRecords() {
+				accountTotals.add(record)
+				summaryTotals.add(record)
+				namespaceTotals[namespace].add(record)
+				summaryNamespaceTotals[namespace].add(record)
+			}
+		}
+
+		incomplete = incomplete || summary.GetIncomplete()
+		state.Summaries = append(state.Summaries, usageSummaryDataModel{
+			StartTime:                  types.StringValue(summary.GetStartTime().AsTime().Format(time.RFC3339)),
+			EndTime:                    types.StringValue(summary.GetEndTime().AsTime().Format(time.RFC3339)),
+			Incomplete:                 types.BoolValue(summary.GetIncomplete()),
+			Actions:                    types.Float64Value(summaryTotals.actions),
+			ActiveStorageByteSeconds:   types.Float64Value(summaryTotals.activeStorage),
+			RetainedStorageByteSeconds: types.Float64Value(summaryTotals.retainedStorage),
+			NamespaceUsages:            toNamespaceUsageModels(summaryNamespaceTotals),
+		})
+	}
+
+	state.Incomplete = types.BoolValue(incomplete)
+	state.TotalActions = types.Float64Value(accountTotals.actions)
+	state.TotalActiveStorageByteSeconds = types.Float64Value(accountTotals.activeStorage)
+	state.TotalRetainedStorageByteSeconds = types.Float64Value(accountTotals.retainedStorage)
+	state.NamespaceUsages = toNamespaceUsageModels(namespaceTotals)
+
+	accResp, err := d.client.CloudService().GetAccount(ctx, &cloudservicev1.GetAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get account information.", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("account-%s-usage", accResp.GetAccount().GetId()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mergeUsageSummaries merges the summaries with the same start time, which GetUsage returns split across
+// pages when the records of a day span more than one page. The order of the first occurrences is kept.
+func mergeUsageSummaries(summaries []*usagev1.Summary) []*usagev1.Summary {
+	merged := make([]*usagev1.Summary, 0, len(summaries))
+	byStartTime := make(map[time.Time]*usagev1.Summary, len(summaries))
+	for _, summary := range summaries {
+		startTime := summary.GetStartTime().AsTime()
+		existing, ok := byStartTime[startTime]
+		if !ok {
+			existing = &usagev1.Summary{
+				StartTime:  summary.GetStartTime(),
+				EndTime:    summary.GetEndTime(),
+				Incomplete: summary.GetIncomplete(),
+			}
+			byStartTime[startTime] = existing
+			merged = append(merged, existing)
+		}
+
+		existing.RecordGroups = append(existing.RecordGroups, summary.GetRecordGroups()...)
+		existing.Incomplete = existing.Incomplete || summary.GetIncomplete()
+		if summary.GetEndTime().AsTime().After(existing.GetEndTime().AsTime()) {
+			existing.EndTime = summary.GetEndTime()
+		}
+	}
+
+	return merged
+}
+
+func (t *usageTotals) add(record *usagev1.Record) {
+	switch record.GetType() {
+	case usagev1.RecordType_RECORD_TYPE_ACTIONS:
+		t.actions += record.GetValue()
+	case usagev1.RecordType_RECORD_TYPE_ACTIVE_STORAGE:
+		t.activeStorage += record.GetValue()
+	case usagev1.RecordType_RECORD_TYPE_RETAINED_STORAGE:
+		t.retainedStorage += record.GetValue()
+	}
+}
+
+func usageRecordGroupNamespace(group *usagev1.RecordGroup) string {
+	for _, groupBy := range group.GetGroupBys() {
+		if groupBy.GetKey() == usagev1.GroupByKey_GROUP_BY_KEY_NAMESPACE {
+			return groupBy.GetValue()
+		}
+	}
+
+	return ""
+}
+
+func toNamespaceUsageModels(totals map[string]*usageTotals) []namespaceUsageModel {
+	namespaces := make([]string, 0, len(totals))
+	for ns := range totals {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	models := make([]namespaceUsageModel, 0, len(namespaces))
+	for _, ns := range namespaces {
+		models = append(models, namespaceUsageModel{
+			Namespace:                  types.StringValue(ns),
+			Actions:                    types.Float64Value(totals[ns].actions),
+			ActiveStorageByteSeconds:   types.Float64Value(totals[ns].activeStorage),
+			RetainedStorageByteSeconds: types.Float64Value(totals[ns].retainedStorage),
+		})
+	}
+
+	return models
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	usagev1 "go.temporal.io/cloud-sdk/api/usage/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUsageDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewUsageDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestMergeUsageSummaries(t *testing.T) {
+	t.Parallel()
+
+	day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	recordGroup := func(namespace string, actions float64) *usagev1.RecordGroup {
+		return &usagev1.RecordGroup{
+			GroupBys: []*usagev1.GroupBy{{Key: usagev1.GroupByKey_GROUP_BY_KEY_NAMESPACE, Value: namespace}},
+			Records:  []*usagev1.Record{{Type: usagev1.RecordType_RECORD_TYPE_ACTIONS, Value: actions}},
+		}
+	}
+	summary := func(start time.Time, incomplete bool, groups ...*usagev1.RecordGroup) *usagev1.Summary {
+		return &usagev1.Summary{
+			StartTime:    timestamppb.New(start),
+			EndTime:      timestamppb.New(start.AddDate(0, 0, 1)),
+			Incomplete:   incomplete,
+			RecordGroups: groups,
+		}
+	}
+
+	// The records of day 2 span the first and the second page.
+	page1 := []*usagev1.Summary{
+		summary(day1, false, recordGroup("ns1", 1), recordGroup("ns2", 2)),
+		summary(day2, false, recordGroup("ns1", 3)),
+	}
+	page2 := []*usagev1.Summary{
+		summary(day2, true, recordGroup("ns2", 4)),
+	}
+
+	merged := mergeUsageSummaries(append(page1, page2...))
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 summaries, got %d", len(merged))
+	}
+	if !merged[0].GetStartTime().AsTime().Equal(day1) || !merged[1].GetStartTime().AsTime().Equal(day2) {
+		t.Fatalf("expected summaries for %s and %s, got %s and %s", day1, day2, merged[0].GetStartTime().AsTime(), merged[1].GetStartTime().AsTime())
+	}
+	if len(merged[0].GetRecordGroups()) != 2 || merged[0].GetIncomplete() {
+		t.Fatalf("expected day 1 to be unchanged, got %+v", merged[0])
+	}
+
+	totals := usageTotals{}
+	for _, group := range merged[1].GetRecordGroups() {
+		for _, record := range group.GetRecords() {
+			totals.add(record)
+		}
+	}
+	if len(merged[1].GetRecordGroups()) != 2 || totals.actions != 7 {
+		t.Fatalf("expected day 2 to combine the record groups of both pages, got %+v", merged[1])
+	}
+	if !merged[1].GetIncomplete() {
+		t.Fatal("expected day 2 to be incomplete when any of its pages is incomplete")
+	}
+}
+
+func TestAccDataSource_Usage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.temporalcloud_usage.test", "id"),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_usage.test", "total_actions"),
+					resource.TestCheckResourceAttrSet("data.temporalcloud_usage.test", "incomplete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsageConfig() string {
+	return `
+provider "temporalcloud" {
+
+}
+
+data "temporalcloud_usage" "test" {}
+
+output "usage" {
+  value = data.temporalcloud_usage.test.namespace_usages
+}
+`
+}
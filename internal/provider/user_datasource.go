@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
 )
@@ -48,7 +50,7 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 
 func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches details about a User.",
+		Description: "Fetches details about a User, by ID or by email.",
 		Attributes:  userSchema(true),
 	}
 }
@@ -60,24 +62,38 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	if len(input.ID.ValueString()) == 0 {
-		resp.Diagnostics.AddError("invalid user id", "user id is required")
-		return
+	var user *identityv1.User
+	if !input.ID.IsNull() {
+		saResp, err := d.client.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{
+			UserId: input.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch user", err.Error())
+			return
+		}
+		user = saResp.GetUser()
+	} else {
+		var err error
+		user, err = findUserByEmail(ctx, d.client, input.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch user", err.Error())
+			return
+		}
+		if user == nil {
+			resp.Diagnostics.AddError("User not found", fmt.Sprintf("No user with email %q found.", input.Email.ValueString()))
+			return
+		}
 	}
 
-	saResp, err := d.client.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{
-		UserId: input.ID.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to fetch user", err.Error())
-		return
-	}
-
-	saDataModel, diags := userToUserDataModel(ctx, saResp.GetUser())
+	saDataModel, diags := userToUserDataModel(ctx, user)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !input.Email.IsNull() {
+		// Keep the email as configured, it may differ in case from the email of the user.
+		saDataModel.Email = input.Email
+	}
 
 	diags = resp.State.Set(ctx, &saDataModel)
 	resp.Diagnostics.Append(diags...)
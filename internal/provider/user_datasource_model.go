@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 
@@ -14,8 +17,13 @@ import (
 
 type (
 	usersDataModel struct {
-		ID    types.String    `tfsdk:"id"`
-		Users []userDataModel `tfsdk:"users"`
+		ID            types.String    `tfsdk:"id"`
+		Email         types.String    `tfsdk:"email"`
+		EmailRegex    types.String    `tfsdk:"email_regex"`
+		AccountAccess types.String    `tfsdk:"account_access"`
+		NamespaceID   types.String    `tfsdk:"namespace_id"`
+		State         types.String    `tfsdk:"state"`
+		Users         []userDataModel `tfsdk:"users"`
 	}
 
 	userDataModel struct {
@@ -101,24 +109,28 @@ func userToUserDataModel(ctx context.Context, sa *identityv1.User) (*userDataMod
 	return userModel, diags
 }
 
-func userSchema(idRequired bool) map[string]schema.Attribute {
+func userSchema(lookup bool) map[string]schema.Attribute {
 	idAttribute := schema.StringAttribute{
 		Description: "The unique identifier of the User.",
+		Computed:    true,
 	}
-
-	switch idRequired {
-	case true:
-		idAttribute.Required = true
-	case false:
-		idAttribute.Computed = true
+	emailAttribute := schema.StringAttribute{
+		Description: "The email of the User.",
+		Computed:    true,
+	}
+	if lookup {
+		idAttribute.Description = "The unique identifier of the User. Exactly one of id or email must be set."
+		idAttribute.Optional = true
+		idAttribute.Validators = []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+		}
+		emailAttribute.Description = "The email of the User, compared case-insensitively. Exactly one of id or email must be set."
+		emailAttribute.Optional = true
 	}
 
 	return map[string]schema.Attribute{
-		"id": idAttribute,
-		"email": schema.StringAttribute{
-			Description: "The email of the User.",
-			Computed:    true,
-		},
+		"id":    idAttribute,
+		"email": emailAttribute,
 		"state": schema.StringAttribute{
 			Description: "The current state of the User.",
 			Computed:    true,
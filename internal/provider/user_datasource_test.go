@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -73,3 +74,39 @@ output "user" {
 		},
 	})
 }
+
+func TestAccDataSource_UserByEmail(t *testing.T) {
+	email := createRandomEmail()
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_user" "terraform" {
+  email          = "%s"
+  account_access = "read"
+}
+
+data "temporalcloud_user" "terraform" {
+  email = "%s"
+
+  depends_on = [temporalcloud_user.terraform]
+}
+`, email, strings.ToUpper(email))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.temporalcloud_user.terraform", "id", "temporalcloud_user.terraform", "id"),
+					resource.TestCheckResourceAttr("data.temporalcloud_user.terraform", "account_access", "read"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+type (
+	userGroupDataSource struct {
+		client *client.Client
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*userGroupDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*userGroupDataSource)(nil)
+)
+
+func NewUserGroupDataSource() datasource.DataSource {
+	return &userGroupDataSource{}
+}
+
+func (d *userGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *userGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *userGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about a group of any type, by ID or by name.",
+		Attributes:  userGroupSchema(true),
+	}
+}
+
+func (d *userGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var input userGroupDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group *identityv1.UserGroup
+	if !input.ID.IsNull() {
+		r, err := d.client.CloudService().GetUserGroup(ctx, &cloudservicev1.GetUserGroupRequest{
+			GroupId: input.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch group", err.Error())
+			return
+		}
+		group = r.GetGroup()
+	} else {
+		var err error
+		group, err = findUserGroupByName(ctx, d.client, input.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch group", err.Error())
+			return
+		}
+		if group == nil {
+			resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group named %q found.", input.Name.ValueString()))
+			return
+		}
+	}
+
+	membersCount, err := countUserGroupMembers(ctx, d.client, group.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to fetch group members", err.Error())
+		return
+	}
+
+	model, diags := userGroupToUserGroupDataModel(ctx, group, membersCount)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findUserGroupByName returns the group with the given name, or nil if there is no such group. It fails if
+// the name is ambiguous.
+func findUserGroupByName(ctx context.Context, c *client.Client, name string) (*identityv1.UserGroup, error) {
+	var found *identityv1.UserGroup
+	req := &cloudservicev1.GetUserGroupsRequest{DisplayName: name}
+	for {
+		r, err := c.CloudService().GetUserGroups(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range r.GetGroups() {
+			if group.GetSpec().GetDisplayName() != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("found more than one group named %q", name)
+			}
+			found = group
+		}
+
+		if r.GetNextPageToken() == "" {
+			return found, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
+)
+
+const (
+	userGroupTypeCloud  = "cloud"
+	userGroupTypeGoogle = "google"
+	userGroupTypeSCIM   = "scim"
+)
+
+type (
+	userGroupDataModel struct {
+		ID                       types.String                             `tfsdk:"id"`
+		Name                     types.String                             `tfsdk:"name"`
+		GroupType                types.String                             `tfsdk:"group_type"`
+		EmailAddress             types.String                             `tfsdk:"email_address"`
+		IdpID                    types.String                             `tfsdk:"idp_id"`
+		State                    types.String                             `tfsdk:"state"`
+		AccountAccess            internaltypes.CaseInsensitiveStringValue `tfsdk:"account_access"`
+		AccountAccessCustomRoles types.Set                                `tfsdk:"account_access_custom_roles"`
+		NamespaceAccesses        types.Set                                `tfsdk:"namespace_accesses"`
+		MembersCount             types.Int64                              `tfsdk:"members_count"`
+		CreatedTime              types.String                             `tfsdk:"created_time"`
+		LastModifiedTime         types.String                             `tfsdk:"last_modified_time"`
+	}
+)
+
+// userGroupSchema returns the attributes of a group data source. With lookup set, the group is looked up by
+// either its ID or its name, otherwise all attributes are computed.
+func userGroupSchema(lookup bool) map[string]schema.Attribute {
+	idAttribute := schema.StringAttribute{
+		Description: "The unique identifier of the group.",
+		Computed:    true,
+	}
+	nameAttribute := schema.StringAttribute{
+		Description: "The name of the group.",
+		Computed:    true,
+	}
+	membersCountAttribute := schema.Int64Attribute{
+		Description: "The number of users in the group. Null unless include_members_count is true, as counting the members takes a request per group.",
+		Computed:    true,
+	}
+	if lookup {
+		idAttribute.Description = "The unique identifier of the group. Exactly one of id or name must be set."
+		idAttribute.Optional = true
+		idAttribute.Validators = []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		}
+		nameAttribute.Description = "The name of the group. Exactly one of id or name must be set."
+		nameAttribute.Optional = true
+		membersCountAttribute.Description = "The number of users in the group."
+	}
+
+	return map[string]schema.Attribute{
+		"id":   idAttribute,
+		"name": nameAttribute,
+		"group_type": schema.StringAttribute{
+			Description: "The type of the group. One of cloud, google, or scim.",
+			Computed:    true,
+		},
+		"email_address": schema.StringAttribute{
+			Description: "The email address of the Google group. Null for other group types.",
+			Computed:    true,
+		},
+		"idp_id": schema.StringAttribute{
+			Description: "The IDP ID of the SCIM group. Null for other group types.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "The current state of the group.",
+			Computed:    true,
+		},
+		"account_access": schema.StringAttribute{
+			CustomType:  internaltypes.CaseInsensitiveStringType{},
+			Description: "The role on the account granted to the members of the group.",
+			Computed:    true,
+		},
+		"account_access_custom_roles": schema.SetAttribute{
+			Description: "The set of custom role IDs granted to the members of the group within account_access.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"namespace_accesses": schema.SetNestedAttribute{
+			Description: "The set of namespace permissions granted to the members of the group.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"namespace_id": schema.StringAttribute{
+						Description: "The namespace the permission is granted on.",
+						Computed:    true,
+					},
+					"permission": schema.StringAttribute{
+						CustomType:  internaltypes.CaseInsensitiveStringType{},
+						Description: "The permission granted on the namespace.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"members_count": membersCountAttribute,
+		"created_time": schema.StringAttribute{
+			Description: "The creation time of the group in ISO 8601 format.",
+			Computed:    true,
+		},
+		"last_modified_time": schema.StringAttribute{
+			Description: "The last modified time of the group in ISO 8601 format. Null if the group was never modified.",
+			Computed:    true,
+		},
+	}
+}
+
+func userGroupToUserGroupDataModel(ctx context.Context, group *identityv1.UserGroup, membersCount int) (*userGroupDataModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stateStr, err := enums.FromResourceState(group.GetState())
+	if err != nil {
+		diags.AddError("Unable to convert group state", err.Error())
+		return nil, diags
+	}
+
+	groupType, err := userGroupTypeOf(group.GetSpec())
+	if err != nil {
+		diags.AddError("Unable to convert group type", err.Error())
+		return nil, diags
+	}
+
+	role, err := enums.FromAccountAccessRole(group.GetSpec().GetAccess().GetAccountAccess().GetRole())
+	if err != nil {
+		diags.AddError("Failed to convert account access role", err.Error())
+		return nil, diags
+	}
+
+	accountAccessCustomRoles, d := getCustomRolesSet(ctx, group.GetSpec().GetAccess().GetAccountAccess().GetCustomRoles())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	namespaceAccesses, d := getNamespaceSetFromSpec(ctx, group.GetSpec().GetAccess())
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	model := &userGroupDataModel{
+		ID:                       types.StringValue(group.GetId()),
+		Name:                     types.StringValue(group.GetSpec().GetDisplayName()),
+		GroupType:                types.StringValue(groupType),
+		EmailAddress:             types.StringNull(),
+		IdpID:                    types.StringNull(),
+		State:                    types.StringValue(stateStr),
+		AccountAccess:            internaltypes.CaseInsensitiveString(role),
+		AccountAccessCustomRoles: accountAccessCustomRoles,
+		NamespaceAccesses:        namespaceAccesses,
+		MembersCount:             types.Int64Value(int64(membersCount)),
+		CreatedTime:              types.StringValue(group.GetCreatedTime().AsTime().Format(time.RFC3339)),
+		LastModifiedTime:         types.StringNull(),
+	}
+	if googleGroup := group.GetSpec().GetGoogleGroup(); googleGroup != nil {
+		model.EmailAddress = types.StringValue(googleGroup.GetEmailAddress())
+	}
+	if scimGroup := group.GetSpec().GetScimGroup(); scimGroup != nil {
+		model.IdpID = types.StringValue(scimGroup.GetIdpId())
+	}
+	if group.GetLastModifiedTime() != nil {
+		model.LastModifiedTime = types.StringValue(group.GetLastModifiedTime().AsTime().Format(time.RFC3339))
+	}
+
+	return model, diags
+}
+
+// userGroupTypeOf returns the type of the group with the given spec, such as cloud or google.
+func userGroupTypeOf(spec *identityv1.UserGroupSpec) (string, error) {
+	switch spec.GetGroupType().(type) {
+	case *identityv1.UserGroupSpec_CloudGroup:
+		return userGroupTypeCloud, nil
+	case *identityv1.UserGroupSpec_GoogleGroup:
+		return userGroupTypeGoogle, nil
+	case *identityv1.UserGroupSpec_ScimGroup:
+		return userGroupTypeSCIM, nil
+	default:
+		return "", fmt.Errorf("unknown group type %T", spec.GetGroupType())
+	}
+}
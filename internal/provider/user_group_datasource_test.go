@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUserGroupDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewUserGroupDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestUserGroupToUserGroupDataModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	createdTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	model, diags := userGroupToUserGroupDataModel(ctx, &identityv1.UserGroup{
+		Id:          "group-id",
+		State:       resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		CreatedTime: timestamppb.New(createdTime),
+		Spec: &identityv1.UserGroupSpec{
+			DisplayName: "developers",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{
+					Role: identityv1.AccountAccess_ROLE_DEVELOPER,
+				},
+				NamespaceAccesses: map[string]*identityv1.NamespaceAccess{
+					"ns.acct": {Permission: identityv1.NamespaceAccess_PERMISSION_WRITE},
+				},
+			},
+			GroupType: &identityv1.UserGroupSpec_GoogleGroup{
+				GoogleGroup: &identityv1.GoogleGroupSpec{EmailAddress: "developers@example.com"},
+			},
+		},
+	}, 3)
+	if diags.HasError() {
+		t.Fatalf("userGroupToUserGroupDataModel diagnostics: %+v", diags)
+	}
+
+	if model.ID.ValueString() != "group-id" || model.Name.ValueString() != "developers" {
+		t.Errorf("unexpected id or name: %q, %q", model.ID.ValueString(), model.Name.ValueString())
+	}
+	if model.GroupType.ValueString() != userGroupTypeGoogle || model.EmailAddress.ValueString() != "developers@example.com" {
+		t.Errorf("unexpected group type or email address: %q, %q", model.GroupType.ValueString(), model.EmailAddress.ValueString())
+	}
+	if !model.IdpID.IsNull() {
+		t.Errorf("expected null idp_id, got %q", model.IdpID.ValueString())
+	}
+	if model.AccountAccess.ValueString() != "developer" {
+		t.Errorf("unexpected account_access %q", model.AccountAccess.ValueString())
+	}
+	if len(model.NamespaceAccesses.Elements()) != 1 {
+		t.Errorf("expected 1 namespace access, got %d", len(model.NamespaceAccesses.Elements()))
+	}
+	if model.MembersCount.ValueInt64() != 3 {
+		t.Errorf("expected 3 members, got %d", model.MembersCount.ValueInt64())
+	}
+	if model.CreatedTime.ValueString() != "2025-06-01T00:00:00Z" {
+		t.Errorf("unexpected created_time %q", model.CreatedTime.ValueString())
+	}
+}
+
+func TestAccUserGroupDataSource(t *testing.T) {
+	name := createRandomName()
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_group" "terraform" {
+  name = "%s"
+}
+
+data "temporalcloud_group" "by_id" {
+  id = temporalcloud_group.terraform.id
+}
+
+data "temporalcloud_group" "by_name" {
+  name = temporalcloud_group.terraform.name
+}
+`, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_group.by_id", "name", name),
+					resource.TestCheckResourceAttr("data.temporalcloud_group.by_id", "group_type", "cloud"),
+					resource.TestCheckResourceAttr("data.temporalcloud_group.by_id", "members_count", "0"),
+					resource.TestCheckResourceAttrPair("data.temporalcloud_group.by_name", "id", "temporalcloud_group.terraform", "id"),
+				),
+			},
+		},
+	})
+}
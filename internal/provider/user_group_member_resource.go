@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+type (
+	userGroupMemberResource struct {
+		client *client.Client
+	}
+
+	userGroupMemberResourceModel struct {
+		ID      types.String `tfsdk:"id"`
+		GroupID types.String `tfsdk:"group_id"`
+		UserID  types.String `tfsdk:"user_id"`
+
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*userGroupMemberResource)(nil)
+	_ resource.ResourceWithConfigure   = (*userGroupMemberResource)(nil)
+	_ resource.ResourceWithImportState = (*userGroupMemberResource)(nil)
+)
+
+func NewUserGroupMemberResource() resource.Resource {
+	return &userGroupMemberResource{}
+}
+
+func (r *userGroupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *userGroupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+func (r *userGroupMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a single user to a group, without managing the other members of the group. Do not combine with a `temporalcloud_group_members` resource for the same group, which removes all members it does not list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this group membership, formatted as `group_id/user_id`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group to add the user to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to add to the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *userGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userGroupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	isMember, err := isUserGroupMember(ctx, r.client, plan.GroupID.ValueString(), plan.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get current group members", err.Error())
+		return
+	}
+	if isMember {
+		resp.Diagnostics.AddError(
+			"Group member already exists",
+			fmt.Sprintf("User `%s` is already a member of group `%s`, import it instead.", plan.UserID.ValueString(), plan.GroupID.ValueString()),
+		)
+		return
+	}
+
+	svcResp, err := r.client.CloudService().AddUserGroupMember(ctx, &cloudservicev1.AddUserGroupMemberRequest{
+		GroupId:  plan.GroupID.ValueString(),
+		MemberId: userGroupMemberID(plan.UserID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add group member", err.Error())
+		return
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		resp.Diagnostics.AddError("Failed to add group member", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupID.ValueString(), plan.UserID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userGroupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isMember, err := isUserGroupMember(ctx, r.client, state.GroupID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			tflog.Warn(ctx, "User Group Resource not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to get group members", err.Error())
+		return
+	}
+	if !isMember {
+		tflog.Warn(ctx, "User Group Member not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *userGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the timeouts can change in place, every other attribute requires replacement.
+	var plan userGroupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userGroupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	svcResp, err := r.client.CloudService().RemoveUserGroupMember(ctx, &cloudservicev1.RemoveUserGroupMemberRequest{
+		GroupId:  state.GroupID.ValueString(),
+		MemberId: userGroupMemberID(state.UserID.ValueString()),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			tflog.Warn(ctx, "User Group Member not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to remove group member", err.Error())
+		return
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		resp.Diagnostics.AddError("Failed to remove group member", err.Error())
+		return
+	}
+}
+
+func (r *userGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, userID, ok := strings.Cut(req.ID, "/")
+	if !ok || groupID == "" || userID == "" || strings.Contains(userID, "/") {
+		resp.Diagnostics.AddError("Invalid import ID for Group member", "The import ID must be in the format `GroupID/UserID`, such as `d4e5f6a1b2c3/a1b2c3d4e5f6`")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+func userGroupMemberID(userID string) *identityv1.UserGroupMemberId {
+	return &identityv1.UserGroupMemberId{
+		MemberType: &identityv1.UserGroupMemberId_UserId{
+			UserId: userID,
+		},
+	}
+}
@@ -3,6 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,9 +36,12 @@ type (
 	}
 
 	userGroupMembersResourceModel struct {
-		ID      types.String `tfsdk:"id"`
-		GroupID types.String `tfsdk:"group_id"`
-		Users   types.Set    `tfsdk:"users"`
+		ID                 types.String `tfsdk:"id"`
+		GroupID            types.String `tfsdk:"group_id"`
+		Users              types.Set    `tfsdk:"users"`
+		MemberEmails       types.Set    `tfsdk:"member_emails"`
+		InviteMissingUsers types.Bool   `tfsdk:"invite_missing_users"`
+		InvitedUsers       types.Set    `tfsdk:"invited_users"`
 
 		Timeouts timeouts.Value `tfsdk:"timeouts"`
 	}
@@ -40,6 +51,7 @@ var (
 	_ resource.Resource                = (*userGroupMembersResource)(nil)
 	_ resource.ResourceWithConfigure   = (*userGroupMembersResource)(nil)
 	_ resource.ResourceWithImportState = (*userGroupMembersResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*userGroupMembersResource)(nil)
 )
 
 var idFmt = "group/%s/members"
@@ -72,7 +84,7 @@ func (r *userGroupMembersResource) Metadata(_ context.Context, req resource.Meta
 
 func (r *userGroupMembersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Sets Group Membership for the provided Group ID. Only use one per group.",
+		Description: "Sets Group Membership for the provided Group ID. Only use one per group, and do not combine with `temporalcloud_group_member` resources for the same group.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the group.",
@@ -89,8 +101,32 @@ func (r *userGroupMembersResource) Schema(ctx context.Context, _ resource.Schema
 				},
 			},
 			"users": schema.SetAttribute{
-				Description: "The users to add to the group.",
-				Required:    true,
+				Description: "The IDs of the users to add to the group. Exactly one of users or member_emails must be set. Computed from member_emails if that is set instead.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("member_emails")),
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"member_emails": schema.SetAttribute{
+				Description: "The email addresses of the users to add to the group, resolved to user IDs when planning. Emails without a user fail the plan, unless invite_missing_users is true.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"invite_missing_users": schema.BoolAttribute{
+				Description: "Whether to invite users for member_emails without a user, with an account_access of none. Invited users are deleted when they are removed from the group, or when the resource is destroyed. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"invited_users": schema.SetAttribute{
+				Description: "The IDs of the users invited by this resource for member_emails without a user. Do not manage these users with other resources, as they are deleted along with their group membership.",
+				Computed:    true,
 				ElementType: types.StringType,
 			},
 		},
@@ -103,6 +139,78 @@ func (r *userGroupMembersResource) Schema(ctx context.Context, _ resource.Schema
 	}
 }
 
+func (r *userGroupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan userGroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || r.client == nil || plan.MemberEmails.IsNull() || plan.MemberEmails.IsUnknown() {
+		return
+	}
+	for _, email := range plan.MemberEmails.Elements() {
+		if email.IsUnknown() {
+			return
+		}
+	}
+
+	emails, d := getUsersFromSet(ctx, plan.MemberEmails)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, unresolved, err := resolveUserEmails(ctx, r.client, emails)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Resolve Member Emails",
+			fmt.Sprintf("Failed to look up users from Temporal Cloud API: %s. The group members will be resolved when applying.", err.Error()),
+		)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), types.SetUnknown(types.StringType))...)
+		return
+	}
+	if len(unresolved) > 0 {
+		if !plan.InviteMissingUsers.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("member_emails"),
+				"Unknown Member Emails",
+				fmt.Sprintf("No users found for %s. Create the users first, or set invite_missing_users to true to invite them.", strings.Join(unresolved, ", ")),
+			)
+			return
+		}
+		// The IDs of the invited users are only known after applying.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), types.SetUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invited_users"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	users, d := types.SetValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("users"), users)...)
+
+	// Invited users that are no longer members are deleted when applying.
+	priorInvited := types.SetNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("invited_users"), &priorInvited)...)
+	}
+	invited, d := getInvitedUsersFromSet(ctx, priorInvited)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	invitedUsers, d := types.SetValueFrom(ctx, types.StringType, retainedInvitedUsers(invited, userIDs))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invited_users"), invitedUsers)...)
+}
+
 func (r *userGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userGroupMembersResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -125,7 +233,7 @@ func (r *userGroupMembersResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	plannedUsers, d := getUsersFromSet(ctx, plan.Users)
+	plannedUsers, invitedUsers, d := r.getPlannedUsers(ctx, &plan, nil)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -134,10 +242,15 @@ func (r *userGroupMembersResource) Create(ctx context.Context, req resource.Crea
 	err = r.setUserGroupMembers(ctx, plan.GroupID.ValueString(), existingUsers, plannedUsers)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to set group members", err.Error())
+		resp.Diagnostics.Append(rollbackInvitedUsers(ctx, r.client, invitedUsers)...)
 		return
 	}
 
 	resp.Diagnostics.Append(updateGroupMembersModelFromSpec(ctx, &plan, plan.GroupID.ValueString(), plannedUsers)...)
+	resp.Diagnostics.Append(setInvitedUsers(ctx, &plan, invitedUsers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -165,10 +278,27 @@ func (r *userGroupMembersResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// Skip for imported group members, which list no users yet.
+	if !state.Users.IsNull() && !state.Users.IsUnknown() {
+		listed, d := getUsersFromSet(ctx, state.Users)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(unlistedGroupMembersWarning(state.GroupID.ValueString(), listed, users)...)
+	}
+
 	resp.Diagnostics.Append(updateGroupMembersModelFromSpec(ctx, &state, state.GroupID.ValueString(), users)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if state.InviteMissingUsers.IsNull() {
+		// Imported group members, or group members created before invite_missing_users existed.
+		state.InviteMissingUsers = types.BoolValue(false)
+	}
+	if state.InvitedUsers.IsNull() {
+		state.InvitedUsers = types.SetValueMust(types.StringType, []attr.Value{})
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -180,12 +310,23 @@ func (r *userGroupMembersResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	var state userGroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	priorInvited, d := getInvitedUsersFromSet(ctx, state.InvitedUsers)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	users, err := getAllUserGroupMembers(ctx, r.client, plan.GroupID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get current group status", err.Error())
 		return
 	}
-	plannedUsers, d := getUsersFromSet(ctx, plan.Users)
+	plannedUsers, invitedUsers, d := r.getPlannedUsers(ctx, &plan, priorInvited)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -194,9 +335,25 @@ func (r *userGroupMembersResource) Update(ctx context.Context, req resource.Upda
 	err = r.setUserGroupMembers(ctx, plan.GroupID.ValueString(), users, plannedUsers)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update group", err.Error())
+		newlyInvited, _ := internaltypes.ListDiff(priorInvited, invitedUsers)
+		resp.Diagnostics.Append(rollbackInvitedUsers(ctx, r.client, newlyInvited)...)
+		return
+	}
+
+	resp.Diagnostics.Append(updateGroupMembersModelFromSpec(ctx, &plan, plan.GroupID.ValueString(), plannedUsers)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Delete the invited users that were removed from the group. On failure they are kept in state, to be
+	// deleted by the next apply.
+	_, removedInvited := internaltypes.ListDiff(priorInvited, invitedUsers)
+	if err := deleteInvitedUsers(ctx, r.client, removedInvited); err != nil {
+		resp.Diagnostics.AddError("Failed to delete invited users", err.Error())
+		invitedUsers = append(invitedUsers, removedInvited...)
+	}
+	resp.Diagnostics.Append(setInvitedUsers(ctx, &plan, invitedUsers)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -236,6 +393,15 @@ func (r *userGroupMembersResource) Delete(ctx context.Context, req resource.Dele
 		resp.Diagnostics.AddError("Failed to delete group members", err.Error())
 		return
 	}
+
+	invited, d := getInvitedUsersFromSet(ctx, state.InvitedUsers)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteInvitedUsers(ctx, r.client, invited); err != nil {
+		resp.Diagnostics.AddError("Failed to delete invited users", err.Error())
+	}
 }
 
 func (r *userGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -287,7 +453,7 @@ func updateGroupMembersModelFromSpec(ctx context.Context, state *userGroupMember
 	var diags diag.Diagnostics
 	state.ID = types.StringValue(fmt.Sprintf(idFmt, groupId))
 	state.GroupID = types.StringValue(groupId)
-	userSet := types.SetNull(types.ObjectType{AttrTypes: namespaceAccessAttrs})
+	userSet := types.SetValueMust(types.StringType, []attr.Value{})
 	if len(users) > 0 {
 		us, d := types.SetValueFrom(ctx, types.StringType, users)
 		diags.Append(d...)
@@ -302,6 +468,185 @@ func updateGroupMembersModelFromSpec(ctx context.Context, state *userGroupMember
 	return diags
 }
 
+// unlistedGroupMembersWarning warns about members of the group that are not listed by the group members resource,
+// such as members added by temporalcloud_group_member or outside of Terraform, as the next apply removes them.
+func unlistedGroupMembersWarning(groupID string, listed, members []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	unlisted, _ := internaltypes.ListDiff(listed, members)
+	if len(unlisted) == 0 {
+		return diags
+	}
+	slices.Sort(unlisted)
+	diags.AddWarning(
+		"Group has unlisted members",
+		fmt.Sprintf("Group `%s` has members %s that temporalcloud_group_members does not list. They are removed by the next apply, unless they are added to the resource. Do not manage members of the group with temporalcloud_group_member or outside of Terraform.", groupID, strings.Join(unlisted, ", ")),
+	)
+	return diags
+}
+
+// getPlannedUsers returns the IDs of the planned group members and of the users invited by the resource,
+// resolving member_emails and inviting missing users if configured. Previously invited users stay invited as
+// long as they are planned members. If inviting fails, the users invited so far are deleted again.
+func (r *userGroupMembersResource) getPlannedUsers(ctx context.Context, plan *userGroupMembersResourceModel, priorInvited []string) ([]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.MemberEmails.IsNull() {
+		users, d := getUsersFromSet(ctx, plan.Users)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		return users, retainedInvitedUsers(priorInvited, users), diags
+	}
+
+	emails, d := getUsersFromSet(ctx, plan.MemberEmails)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	userIDs, unresolved, err := resolveUserEmails(ctx, r.client, emails)
+	if err != nil {
+		diags.AddError("Failed to resolve member emails", err.Error())
+		return nil, nil, diags
+	}
+	if len(unresolved) > 0 && !plan.InviteMissingUsers.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("member_emails"),
+			"Unknown Member Emails",
+			fmt.Sprintf("No users found for %s. Create the users first, or set invite_missing_users to true to invite them.", strings.Join(unresolved, ", ")),
+		)
+		return nil, nil, diags
+	}
+
+	accountAccess, d := getAccountAccessFromModel(ctx, "none", types.SetNull(types.StringType))
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	invited := retainedInvitedUsers(priorInvited, userIDs)
+	var newlyInvited []string
+	for _, email := range unresolved {
+		userID, err := r.inviteUser(ctx, email, accountAccess)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to invite user %s", email), err.Error())
+			diags.Append(rollbackInvitedUsers(ctx, r.client, newlyInvited)...)
+			return nil, nil, diags
+		}
+		newlyInvited = append(newlyInvited, userID)
+	}
+
+	return append(userIDs, newlyInvited...), append(invited, newlyInvited...), diags
+}
+
+func (r *userGroupMembersResource) inviteUser(ctx context.Context, email string, accountAccess *identityv1.AccountAccess) (string, error) {
+	svcResp, err := r.client.CloudService().CreateUser(ctx, &cloudservicev1.CreateUserRequest{
+		Spec: &identityv1.UserSpec{
+			Email: email,
+			Access: &identityv1.Access{
+				AccountAccess: accountAccess,
+			},
+		},
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		return "", err
+	}
+	return svcResp.GetUserId(), nil
+}
+
+// rollbackInvitedUsers deletes the users invited by an apply that failed, so that they are not left behind
+// without being tracked in state.
+func rollbackInvitedUsers(ctx context.Context, c *client.Client, userIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(userIDs) == 0 {
+		return diags
+	}
+	if err := deleteInvitedUsers(ctx, c, userIDs); err != nil {
+		diags.AddError(
+			"Failed to delete invited users",
+			fmt.Sprintf("Users %s were invited, but could not be deleted after the failure: %s. Delete them manually.", strings.Join(userIDs, ", "), err.Error()),
+		)
+	}
+	return diags
+}
+
+// deleteInvitedUsers deletes users invited by a group members resource. Users that no longer exist are skipped.
+func deleteInvitedUsers(ctx context.Context, c *client.Client, userIDs []string) error {
+	for _, userID := range userIDs {
+		userResp, err := c.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{UserId: userID})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return err
+		}
+
+		svcResp, err := c.CloudService().DeleteUser(ctx, &cloudservicev1.DeleteUserRequest{
+			UserId:           userID,
+			ResourceVersion:  userResp.GetUser().GetResourceVersion(),
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return err
+		}
+		if err := client.AwaitAsyncOperation(ctx, c, svcResp.GetAsyncOperation()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retainedInvitedUsers returns the invited users that are still planned members.
+func retainedInvitedUsers(invited, planned []string) []string {
+	retained := make([]string, 0, len(invited))
+	for _, userID := range invited {
+		if slices.Contains(planned, userID) {
+			retained = append(retained, userID)
+		}
+	}
+	return retained
+}
+
+func getInvitedUsersFromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	return getUsersFromSet(ctx, set)
+}
+
+func setInvitedUsers(ctx context.Context, state *userGroupMembersResourceModel, invited []string) diag.Diagnostics {
+	invitedUsers, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, invited...))
+	state.InvitedUsers = invitedUsers
+	return diags
+}
+
+// resolveUserEmails returns the IDs of the users with the given emails, and the emails without a user.
+func resolveUserEmails(ctx context.Context, c *client.Client, emails []string) ([]string, []string, error) {
+	userIDs := make([]string, 0, len(emails))
+	var unresolved []string
+	for _, email := range emails {
+		user, err := findUserByEmail(ctx, c, email)
+		if err != nil {
+			return nil, nil, err
+		}
+		if user == nil {
+			unresolved = append(unresolved, email)
+			continue
+		}
+		userIDs = append(userIDs, user.GetId())
+	}
+
+	return userIDs, unresolved, nil
+}
+
 func getAllUserGroupMembers(ctx context.Context, client *client.Client, groupID string) ([]string, error) {
 	var users []string
 	pageToken := ""
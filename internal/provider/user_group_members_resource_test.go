@@ -5,12 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	operationv1 "go.temporal.io/cloud-sdk/api/operation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGroupMembers_Schema(t *testing.T) {
@@ -35,6 +44,142 @@ func TestGroupMembers_Schema(t *testing.T) {
 	}
 }
 
+func TestUnlistedGroupMembersWarning(t *testing.T) {
+	t.Parallel()
+
+	if diags := unlistedGroupMembersWarning("group-a", []string{"user-1", "user-2"}, []string{"user-1"}); len(diags) != 0 {
+		t.Errorf("expected no warning without unlisted members, got %+v", diags)
+	}
+	diags := unlistedGroupMembersWarning("group-a", []string{"user-1"}, []string{"user-3", "user-1", "user-2"})
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("expected a warning for unlisted members, got %+v", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "user-2, user-3") {
+		t.Errorf("expected the unlisted members in the warning, got %q", detail)
+	}
+}
+
+// fakeInviteCloudService serves users from memory. Creating a user fails for the configured email.
+type fakeInviteCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	mu        sync.Mutex
+	users     map[string]*identityv1.User
+	failEmail string
+}
+
+func (f *fakeInviteCloudService) GetUsers(_ context.Context, req *cloudservicev1.GetUsersRequest) (*cloudservicev1.GetUsersResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	resp := &cloudservicev1.GetUsersResponse{}
+	for _, user := range f.users {
+		if user.GetSpec().GetEmail() == req.GetEmail() {
+			resp.Users = append(resp.Users, user)
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeInviteCloudService) CreateUser(_ context.Context, req *cloudservicev1.CreateUserRequest) (*cloudservicev1.CreateUserResponse, error) {
+	if req.GetSpec().GetEmail() == f.failEmail {
+		return nil, status.Error(codes.FailedPrecondition, "invalid email")
+	}
+	if req.GetSpec().GetAccess().GetAccountAccess() != nil {
+		return nil, status.Error(codes.InvalidArgument, "account access must be unset")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := "user-" + req.GetSpec().GetEmail()
+	f.users[id] = &identityv1.User{Id: id, Spec: req.GetSpec(), ResourceVersion: "1"}
+	return &cloudservicev1.CreateUserResponse{UserId: id, AsyncOperation: &operationv1.AsyncOperation{Id: "create"}}, nil
+}
+
+func (f *fakeInviteCloudService) GetUser(_ context.Context, req *cloudservicev1.GetUserRequest) (*cloudservicev1.GetUserResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.users[req.GetUserId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &cloudservicev1.GetUserResponse{User: user}, nil
+}
+
+func (f *fakeInviteCloudService) DeleteUser(_ context.Context, req *cloudservicev1.DeleteUserRequest) (*cloudservicev1.DeleteUserResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.users, req.GetUserId())
+	return &cloudservicev1.DeleteUserResponse{AsyncOperation: &operationv1.AsyncOperation{Id: "delete"}}, nil
+}
+
+func (f *fakeInviteCloudService) GetAsyncOperation(_ context.Context, req *cloudservicev1.GetAsyncOperationRequest) (*cloudservicev1.GetAsyncOperationResponse, error) {
+	return &cloudservicev1.GetAsyncOperationResponse{
+		AsyncOperation: &operationv1.AsyncOperation{Id: req.GetAsyncOperationId(), State: operationv1.AsyncOperation_STATE_FULFILLED},
+	}, nil
+}
+
+func TestGroupMembersGetPlannedUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newPlan := func(emails ...string) *userGroupMembersResourceModel {
+		return &userGroupMembersResourceModel{
+			GroupID:            types.StringValue("group"),
+			MemberEmails:       types.SetValueMust(types.StringType, stringValues(emails)),
+			InviteMissingUsers: types.BoolValue(true),
+		}
+	}
+
+	t.Run("invites missing users and keeps previous invitees", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeInviteCloudService{users: map[string]*identityv1.User{
+			"existing": {Id: "existing", Spec: &identityv1.UserSpec{Email: "existing@example.com"}},
+			"invited":  {Id: "invited", Spec: &identityv1.UserSpec{Email: "invited@example.com"}},
+		}}
+		r := &userGroupMembersResource{client: newTestClient(t, fake)}
+
+		users, invited, diags := r.getPlannedUsers(ctx, newPlan("existing@example.com", "invited@example.com", "new@example.com"), []string{"invited", "removed"})
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %+v", diags)
+		}
+		slices.Sort(users)
+		if want := []string{"existing", "invited", "user-new@example.com"}; !slices.Equal(users, want) {
+			t.Errorf("users = %v, want %v", users, want)
+		}
+		if want := []string{"invited", "user-new@example.com"}; !slices.Equal(invited, want) {
+			t.Errorf("invited users = %v, want %v", invited, want)
+		}
+	})
+
+	t.Run("deletes invitees when an invite fails", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeInviteCloudService{users: map[string]*identityv1.User{}, failEmail: "b@example.com"}
+		r := &userGroupMembersResource{client: newTestClient(t, fake)}
+
+		_, _, diags := r.getPlannedUsers(ctx, newPlan("a@example.com", "b@example.com"), nil)
+		if !diags.HasError() {
+			t.Fatal("expected an error")
+		}
+		if len(fake.users) != 0 {
+			t.Errorf("expected the invited users to be deleted, got %v", fake.users)
+		}
+	})
+}
+
+func stringValues(values []string) []attr.Value {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return elems
+}
+
 func TestAccGroupMembers_Basic(t *testing.T) {
 	name := createRandomName()
 	emailAddr := createRandomEmail()
@@ -144,3 +289,76 @@ resource "temporalcloud_group_members" "terraform" {
 		},
 	})
 }
+
+func TestAccGroupMembers_MemberEmails(t *testing.T) {
+	name := createRandomName()
+	emailAddr := createRandomEmail()
+	unknownEmailAddr := createRandomEmail()
+	config := func(email, name, memberEmails string, invite bool) string {
+		members := ""
+		if memberEmails != "" {
+			members = fmt.Sprintf(`
+resource "temporalcloud_group_members" "terraform" {
+  group_id             = temporalcloud_group.terraform.id
+  member_emails        = %s
+  invite_missing_users = %t
+}
+`, memberEmails, invite)
+		}
+
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_user" "user1" {
+  email = "%s"
+  account_access = "read"
+}
+
+resource "temporalcloud_group" "terraform" {
+  name = "%s"
+}
+%s`, email, name, members)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Emails are resolved when planning, so the user must exist first.
+				Config: config(emailAddr, name, "", false),
+			},
+			{
+				Config: config(emailAddr, name, fmt.Sprintf(`["%s"]`, emailAddr), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("temporalcloud_group_members.terraform", "users.*", "temporalcloud_user.user1", "id"),
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "invited_users.#", "0"),
+				),
+			},
+			{
+				Config:      config(emailAddr, name, fmt.Sprintf(`["%s", "%s"]`, emailAddr, unknownEmailAddr), false),
+				ExpectError: regexp.MustCompile("Unknown Member Emails"),
+			},
+			{
+				Config: config(emailAddr, name, fmt.Sprintf(`["%s", "%s"]`, emailAddr, unknownEmailAddr), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "users.#", "2"),
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "invited_users.#", "1"),
+				),
+			},
+			{
+				// Removing the invited user from the group deletes it.
+				Config: config(emailAddr, name, fmt.Sprintf(`["%s"]`, emailAddr), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "users.#", "1"),
+					resource.TestCheckResourceAttr("temporalcloud_group_members.terraform", "invited_users.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -6,12 +6,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
@@ -29,18 +32,22 @@ type (
 	}
 
 	userGroupResourceModel struct {
-		ID    types.String `tfsdk:"id"`
-		State types.String `tfsdk:"state"`
-		Name  types.String `tfsdk:"name"`
+		ID           types.String `tfsdk:"id"`
+		State        types.String `tfsdk:"state"`
+		Name         types.String `tfsdk:"name"`
+		GroupType    types.String `tfsdk:"group_type"`
+		EmailAddress types.String `tfsdk:"email_address"`
+		IdpID        types.String `tfsdk:"idp_id"`
 
 		Timeouts timeouts.Value `tfsdk:"timeouts"`
 	}
 )
 
 var (
-	_ resource.Resource                = (*userGroupResource)(nil)
-	_ resource.ResourceWithConfigure   = (*userGroupResource)(nil)
-	_ resource.ResourceWithImportState = (*userGroupResource)(nil)
+	_ resource.Resource                   = (*userGroupResource)(nil)
+	_ resource.ResourceWithConfigure      = (*userGroupResource)(nil)
+	_ resource.ResourceWithImportState    = (*userGroupResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*userGroupResource)(nil)
 )
 
 func NewUserGroupResource() resource.Resource {
@@ -88,8 +95,38 @@ func (r *userGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the group",
-				Required:    true,
+				Description: "The name of the group. Required for cloud and google groups. Must not be set for scim groups, whose name is managed by the identity provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_type": schema.StringAttribute{
+				Description: "The type of the group. One of cloud, google, or scim. Defaults to cloud. A google group is linked to a Google Group by its email_address. A scim group is not created but adopted: the existing SCIM group with the given idp_id is managed, and is left in place when the resource is destroyed. Changing the type forces a new group to be created.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(userGroupTypeCloud),
+				Validators: []validator.String{
+					stringvalidator.OneOf(userGroupTypeCloud, userGroupTypeGoogle, userGroupTypeSCIM),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				Description: "The email address of the Google Group. Required for google groups, and must not be set otherwise. Changing it forces a new group to be created.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"idp_id": schema.StringAttribute{
+				Description: "The IDP ID of the SCIM group to adopt. Required for scim groups, and must not be set otherwise. Changing it forces a new group to be adopted.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -103,6 +140,16 @@ func (r *userGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest
 	resp.Schema = s
 }
 
+func (r *userGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateUserGroupTypeConfig(config.GroupType, config.Name, config.EmailAddress, config.IdpID)...)
+}
+
 func (r *userGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userGroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -119,13 +166,47 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 	ctx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
-	svcResp, err := r.client.CloudService().CreateUserGroup(ctx, &cloudservicev1.CreateUserGroupRequest{
-		Spec: &identityv1.UserGroupSpec{
-			DisplayName: plan.Name.ValueString(),
-			GroupType: &identityv1.UserGroupSpec_CloudGroup{
-				CloudGroup: &identityv1.CloudGroupSpec{},
-			},
+	if plan.GroupType.ValueString() == userGroupTypeSCIM {
+		// SCIM groups are provisioned by the identity provider, so they are adopted rather than created.
+		group, err := findSCIMGroupByIdpID(ctx, r.client, plan.IdpID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get SCIM group", err.Error())
+			return
+		}
+		if group == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("idp_id"),
+				"SCIM group not found",
+				fmt.Sprintf("No SCIM group with IDP ID %q found. SCIM groups must be provisioned by the identity provider before they can be adopted.", plan.IdpID.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(updateGroupModelFromSpec(ctx, &plan, group)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	spec := &identityv1.UserGroupSpec{
+		DisplayName: plan.Name.ValueString(),
+		GroupType: &identityv1.UserGroupSpec_CloudGroup{
+			CloudGroup: &identityv1.CloudGroupSpec{},
 		},
+	}
+	if plan.GroupType.ValueString() == userGroupTypeGoogle {
+		spec.GroupType = &identityv1.UserGroupSpec_GoogleGroup{
+			GoogleGroup: &identityv1.GoogleGroupSpec{
+				EmailAddress: plan.EmailAddress.ValueString(),
+			},
+		}
+	}
+
+	svcResp, err := r.client.CloudService().CreateUserGroup(ctx, &cloudservicev1.CreateUserGroupRequest{
+		Spec:             spec,
 		AsyncOperationId: uuid.New().String(),
 	})
 	if err != nil {
@@ -206,9 +287,8 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		Spec: &identityv1.UserGroupSpec{
 			DisplayName: plan.Name.ValueString(),
 			Access:      currentGroup.GetGroup().GetSpec().GetAccess(),
-			GroupType: &identityv1.UserGroupSpec_CloudGroup{
-				CloudGroup: &identityv1.CloudGroupSpec{},
-			},
+			// The group type cannot change in place, so keep the existing type spec.
+			GroupType: currentGroup.GetGroup().GetSpec().GetGroupType(),
 		},
 		ResourceVersion:  currentGroup.GetGroup().GetResourceVersion(),
 		AsyncOperationId: uuid.New().String(),
@@ -246,6 +326,14 @@ func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.GroupType.ValueString() == userGroupTypeSCIM {
+		tflog.Info(ctx, "Adopted SCIM group is managed by the identity provider, removing from state without deleting it", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		return
+	}
+
 	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -311,5 +399,59 @@ func updateGroupModelFromSpec(_ context.Context, state *userGroupResourceModel,
 	state.State = types.StringValue(stateStr)
 	state.Name = types.StringValue(group.GetSpec().GetDisplayName())
 
+	groupType, err := userGroupTypeOf(group.GetSpec())
+	if err != nil {
+		diags.AddError("Failed to convert group type", err.Error())
+		return diags
+	}
+	state.GroupType = types.StringValue(groupType)
+	state.EmailAddress = types.StringNull()
+	if googleGroup := group.GetSpec().GetGoogleGroup(); googleGroup != nil {
+		state.EmailAddress = types.StringValue(googleGroup.GetEmailAddress())
+	}
+	state.IdpID = types.StringNull()
+	if scimGroup := group.GetSpec().GetScimGroup(); scimGroup != nil {
+		state.IdpID = types.StringValue(scimGroup.GetIdpId())
+	}
+
+	return diags
+}
+
+// validateUserGroupTypeConfig checks that the attributes configured for a group match its type.
+func validateUserGroupTypeConfig(groupType, name, emailAddress, idpID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if groupType.IsUnknown() {
+		return diags
+	}
+
+	t := groupType.ValueString()
+	if groupType.IsNull() {
+		t = userGroupTypeCloud
+	}
+
+	if t == userGroupTypeSCIM {
+		if !name.IsNull() {
+			diags.AddAttributeError(path.Root("name"), "Invalid Group Configuration", "name must not be set for scim groups, it is managed by the identity provider.")
+		}
+	} else if name.IsNull() {
+		diags.AddAttributeError(path.Root("name"), "Invalid Group Configuration", fmt.Sprintf("name must be set for %s groups.", t))
+	}
+
+	if t == userGroupTypeGoogle {
+		if emailAddress.IsNull() {
+			diags.AddAttributeError(path.Root("email_address"), "Invalid Group Configuration", "email_address must be set for google groups.")
+		}
+	} else if !emailAddress.IsNull() {
+		diags.AddAttributeError(path.Root("email_address"), "Invalid Group Configuration", fmt.Sprintf("email_address must not be set for %s groups.", t))
+	}
+
+	if t == userGroupTypeSCIM {
+		if idpID.IsNull() {
+			diags.AddAttributeError(path.Root("idp_id"), "Invalid Group Configuration", "idp_id must be set for scim groups.")
+		}
+	} else if !idpID.IsNull() {
+		diags.AddAttributeError(path.Root("idp_id"), "Invalid Group Configuration", fmt.Sprintf("idp_id must not be set for %s groups.", t))
+	}
+
 	return diags
 }
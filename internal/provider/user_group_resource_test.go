@@ -6,10 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 	"text/template"
 
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -40,6 +42,147 @@ func TestGroupSchema(t *testing.T) {
 	}
 }
 
+func TestValidateUserGroupTypeConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		groupType    types.String
+		groupName    types.String
+		emailAddress types.String
+		idpID        types.String
+		wantErrors   int
+	}{
+		{
+			name:         "default cloud group",
+			groupType:    types.StringNull(),
+			groupName:    types.StringValue("developers"),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringNull(),
+		},
+		{
+			name:         "cloud group without name",
+			groupType:    types.StringValue(userGroupTypeCloud),
+			groupName:    types.StringNull(),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringNull(),
+			wantErrors:   1,
+		},
+		{
+			name:         "google group",
+			groupType:    types.StringValue(userGroupTypeGoogle),
+			groupName:    types.StringValue("developers"),
+			emailAddress: types.StringValue("developers@example.com"),
+			idpID:        types.StringNull(),
+		},
+		{
+			name:         "google group without email address",
+			groupType:    types.StringValue(userGroupTypeGoogle),
+			groupName:    types.StringValue("developers"),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringNull(),
+			wantErrors:   1,
+		},
+		{
+			name:         "scim group",
+			groupType:    types.StringValue(userGroupTypeSCIM),
+			groupName:    types.StringNull(),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringValue("idp-id"),
+		},
+		{
+			name:         "scim group with name and email address",
+			groupType:    types.StringValue(userGroupTypeSCIM),
+			groupName:    types.StringValue("developers"),
+			emailAddress: types.StringValue("developers@example.com"),
+			idpID:        types.StringValue("idp-id"),
+			wantErrors:   2,
+		},
+		{
+			name:         "cloud group with idp id",
+			groupType:    types.StringValue(userGroupTypeCloud),
+			groupName:    types.StringValue("developers"),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringValue("idp-id"),
+			wantErrors:   1,
+		},
+		{
+			name:         "unknown group type",
+			groupType:    types.StringUnknown(),
+			groupName:    types.StringNull(),
+			emailAddress: types.StringNull(),
+			idpID:        types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateUserGroupTypeConfig(tt.groupType, tt.groupName, tt.emailAddress, tt.idpID)
+			if diags.ErrorsCount() != tt.wantErrors {
+				t.Errorf("validateUserGroupTypeConfig() errors = %d, want %d: %+v", diags.ErrorsCount(), tt.wantErrors, diags)
+			}
+		})
+	}
+}
+
+// fakePagedSCIMGroupsCloudService serves each of its SCIM groups on a page of its own.
+type fakePagedSCIMGroupsCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	groups []*identityv1.UserGroup
+}
+
+func (f *fakePagedSCIMGroupsCloudService) GetUserGroups(_ context.Context, req *cloudservicev1.GetUserGroupsRequest) (*cloudservicev1.GetUserGroupsResponse, error) {
+	page := 0
+	if req.GetPageToken() != "" {
+		page, _ = strconv.Atoi(req.GetPageToken())
+	}
+	resp := &cloudservicev1.GetUserGroupsResponse{}
+	if page < len(f.groups) {
+		resp.Groups = []*identityv1.UserGroup{f.groups[page]}
+	}
+	if page+1 < len(f.groups) {
+		resp.NextPageToken = strconv.Itoa(page + 1)
+	}
+	return resp, nil
+}
+
+func TestFindSCIMGroupByIdpID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scimGroup := func(id, idpID string) *identityv1.UserGroup {
+		return &identityv1.UserGroup{
+			Id:   id,
+			Spec: &identityv1.UserGroupSpec{GroupType: &identityv1.UserGroupSpec_ScimGroup{ScimGroup: &identityv1.SCIMGroupSpec{IdpId: idpID}}},
+		}
+	}
+
+	c := newTestClient(t, &fakePagedSCIMGroupsCloudService{groups: []*identityv1.UserGroup{
+		scimGroup("group-1", "idp-1"),
+		scimGroup("group-2", "idp-2"),
+		scimGroup("group-3", "idp-3"),
+		scimGroup("group-4", "idp-3"),
+	}})
+
+	group, err := findSCIMGroupByIdpID(ctx, c, "idp-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.GetId() != "group-2" {
+		t.Errorf("expected group-2 from the second page, got %q", group.GetId())
+	}
+
+	group, err = findSCIMGroupByIdpID(ctx, c, "idp-unknown")
+	if err != nil || group != nil {
+		t.Errorf("expected no group, got %v, %v", group, err)
+	}
+
+	if _, err := findSCIMGroupByIdpID(ctx, c, "idp-3"); err == nil {
+		t.Error("expected an error for an ambiguous IDP ID")
+	}
+}
+
 func TestAccGroup_Basic(t *testing.T) {
 	name := createRandomName()
 	nameUpdate := createRandomName()
@@ -62,6 +205,7 @@ resource "temporalcloud_group" "terraform" {
 		Steps: []resource.TestStep{
 			{
 				Config: config(name),
+				Check:  resource.TestCheckResourceAttr("temporalcloud_group.terraform", "group_type", "cloud"),
 			},
 			{
 				Config: config(nameUpdate),
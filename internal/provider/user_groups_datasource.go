@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+)
+
+type (
+	userGroupsDataSource struct {
+		client *client.Client
+	}
+
+	userGroupsDataModel struct {
+		ID                  types.String         `tfsdk:"id"`
+		Name                types.String         `tfsdk:"name"`
+		NamePrefix          types.String         `tfsdk:"name_prefix"`
+		GroupType           types.String         `tfsdk:"group_type"`
+		NamespaceID         types.String         `tfsdk:"namespace_id"`
+		IncludeMembersCount types.Bool           `tfsdk:"include_members_count"`
+		Groups              []userGroupDataModel `tfsdk:"groups"`
+	}
+)
+
+var (
+	_ datasource.DataSource              = (*userGroupsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*userGroupsDataSource)(nil)
+)
+
+func NewUserGroupsDataSource() datasource.DataSource {
+	return &userGroupsDataSource{}
+}
+
+func (d *userGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *userGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *userGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches details about all groups of the account, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the groups data source.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "If set, only groups with this name are returned. Unlike name_prefix, this filter is applied by the Temporal Cloud API.",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "If set, only groups whose name starts with this prefix are returned. The Temporal Cloud API cannot filter by prefix, so all groups are listed and filtered by the provider.",
+				Optional:    true,
+			},
+			"group_type": schema.StringAttribute{
+				Description: "If set, only groups of this type are returned. One of cloud, google, or scim.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userGroupTypeCloud, userGroupTypeGoogle, userGroupTypeSCIM),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Description: "If set, only groups with access to this namespace are returned.",
+				Optional:    true,
+			},
+			"include_members_count": schema.BoolAttribute{
+				Description: "Whether to set members_count of the groups. Counting the members takes a request per group. Defaults to false.",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The list of groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userGroupSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *userGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userGroupsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsReq := &cloudservicev1.GetUserGroupsRequest{
+		PageSize:    1000,
+		Namespace:   state.NamespaceID.ValueString(),
+		DisplayName: state.Name.ValueString(),
+	}
+
+	var groups []*identityv1.UserGroup
+	for {
+		r, err := d.client.CloudService().GetUserGroups(ctx, groupsReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch groups", err.Error())
+			return
+		}
+
+		for _, group := range r.GetGroups() {
+			matches, err := userGroupMatchesFilters(group, state.Name.ValueString(), state.NamePrefix.ValueString(), state.GroupType.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to convert group type", err.Error())
+				return
+			}
+			if matches {
+				groups = append(groups, group)
+			}
+		}
+
+		if r.GetNextPageToken() == "" {
+			break
+		}
+
+		groupsReq.PageToken = r.GetNextPageToken()
+	}
+
+	state.Groups = make([]userGroupDataModel, 0, len(groups))
+	for _, group := range groups {
+		membersCount := 0
+		if state.IncludeMembersCount.ValueBool() {
+			count, err := countUserGroupMembers(ctx, d.client, group.GetId())
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to fetch group members", err.Error())
+				return
+			}
+			membersCount = count
+		}
+
+		model, diags := userGroupToUserGroupDataModel(ctx, group, membersCount)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.IncludeMembersCount.ValueBool() {
+			model.MembersCount = types.Int64Null()
+		}
+
+		state.Groups = append(state.Groups, *model)
+	}
+
+	accResp, err := d.client.CloudService().GetAccount(ctx, &cloudservicev1.GetAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get account information.", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("account-%s-groups", accResp.GetAccount().GetId()))
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// userGroupMatchesFilters reports whether the group has the given name, name prefix and group type. Empty
+// filters match every group. The name is also filtered by the API, and only checked again here.
+func userGroupMatchesFilters(group *identityv1.UserGroup, name string, namePrefix string, groupType string) (bool, error) {
+	if name != "" && group.GetSpec().GetDisplayName() != name {
+		return false, nil
+	}
+	if !strings.HasPrefix(group.GetSpec().GetDisplayName(), namePrefix) {
+		return false, nil
+	}
+	if groupType == "" {
+		return true, nil
+	}
+
+	t, err := userGroupTypeOf(group.GetSpec())
+	if err != nil {
+		return false, err
+	}
+	return t == groupType, nil
+}
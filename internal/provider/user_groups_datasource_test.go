@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+)
+
+func TestUserGroupsDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewUserGroupsDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestUserGroupMatchesFilters(t *testing.T) {
+	t.Parallel()
+
+	cloudGroup := &identityv1.UserGroup{
+		Spec: &identityv1.UserGroupSpec{
+			DisplayName: "eng-backend",
+			GroupType:   &identityv1.UserGroupSpec_CloudGroup{CloudGroup: &identityv1.CloudGroupSpec{}},
+		},
+	}
+	scimGroup := &identityv1.UserGroup{
+		Spec: &identityv1.UserGroupSpec{
+			DisplayName: "eng-frontend",
+			GroupType:   &identityv1.UserGroupSpec_ScimGroup{ScimGroup: &identityv1.SCIMGroupSpec{IdpId: "idp-id"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		group      *identityv1.UserGroup
+		groupName  string
+		namePrefix string
+		groupType  string
+		want       bool
+	}{
+		{name: "no filters", group: cloudGroup, want: true},
+		{name: "matching prefix", group: cloudGroup, namePrefix: "eng-", want: true},
+		{name: "other prefix", group: cloudGroup, namePrefix: "ops-", want: false},
+		{name: "matching name", group: cloudGroup, groupName: "eng-backend", want: true},
+		{name: "other name", group: cloudGroup, groupName: "eng-", want: false},
+		{name: "matching type", group: scimGroup, groupType: userGroupTypeSCIM, want: true},
+		{name: "other type", group: scimGroup, groupType: userGroupTypeCloud, want: false},
+		{name: "matching prefix and type", group: scimGroup, namePrefix: "eng-front", groupType: userGroupTypeSCIM, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := userGroupMatchesFilters(tt.group, tt.groupName, tt.namePrefix, tt.groupType)
+			if err != nil {
+				t.Fatalf("userGroupMatchesFilters() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("userGroupMatchesFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccUserGroupsDataSource(t *testing.T) {
+	name := createRandomName()
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_group" "terraform" {
+  name = "%s"
+}
+
+data "temporalcloud_groups" "terraform" {
+  name_prefix = temporalcloud_group.terraform.name
+  group_type  = "cloud"
+}
+
+data "temporalcloud_groups" "by_name" {
+  name                  = temporalcloud_group.terraform.name
+  include_members_count = true
+}
+`, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_groups.terraform", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.temporalcloud_groups.terraform", "groups.0.id", "temporalcloud_group.terraform", "id"),
+					resource.TestCheckNoResourceAttr("data.temporalcloud_groups.terraform", "groups.0.members_count"),
+					resource.TestCheckResourceAttr("data.temporalcloud_groups.by_name", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.temporalcloud_groups.by_name", "groups.0.id", "temporalcloud_group.terraform", "id"),
+					resource.TestCheckResourceAttr("data.temporalcloud_groups.by_name", "groups.0.members_count", "0"),
+				),
+			},
+		},
+	})
+}
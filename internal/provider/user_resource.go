@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -13,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -30,6 +35,12 @@ import (
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/validation"
 )
 
+const (
+	userInvitationStatusPending = "pending"
+	userInvitationStatusExpired = "expired"
+	userInvitationStatusNone    = "none"
+)
+
 type (
 	userResource struct {
 		client *client.Client
@@ -42,6 +53,11 @@ type (
 		AccountAccess            internaltypes.CaseInsensitiveStringValue `tfsdk:"account_access"`
 		AccountAccessCustomRoles types.Set                                `tfsdk:"account_access_custom_roles"`
 		NamespaceAccesses        types.Set                                `tfsdk:"namespace_accesses"`
+		ManagedBySCIM            types.Bool                               `tfsdk:"managed_by_scim"`
+		IgnoreSCIMManagedFields  types.Bool                               `tfsdk:"ignore_scim_managed_fields"`
+		AdoptExisting            types.Bool                               `tfsdk:"adopt_existing"`
+		InvitationStatus         types.String                             `tfsdk:"invitation_status"`
+		InvitationExpiresAt      types.String                             `tfsdk:"invitation_expires_at"`
 
 		Timeouts timeouts.Value `tfsdk:"timeouts"`
 	}
@@ -56,6 +72,7 @@ var (
 	_ resource.Resource                = (*userResource)(nil)
 	_ resource.ResourceWithConfigure   = (*userResource)(nil)
 	_ resource.ResourceWithImportState = (*userResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*userResource)(nil)
 
 	userNamespaceAccessAttrs = map[string]attr.Type{
 		"namespace_id": types.StringType,
@@ -91,7 +108,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Provisions a Temporal Cloud user.",
+		Description: "Provisions a Temporal Cloud user. Creating a user whose email already exists fails, unless adopt_existing is true. Existing users can also be imported.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the user.",
@@ -155,6 +172,39 @@ func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, res
 					validation.SetNestedAttributeMustBeUnique("namespace_id"),
 				},
 			},
+			"managed_by_scim": schema.BoolAttribute{
+				Description: "Whether the user is managed via SCIM, that is, whether the user is a member of a SCIM group. Only determined if ignore_scim_managed_fields is true, as it requires listing the members of every SCIM group, and null otherwise. To limit these requests, it is only determined again on refresh when the access of the user in Temporal Cloud differs from the state.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_scim_managed_fields": schema.BoolAttribute{
+				Description: "If true and the user is managed via SCIM, account_access, account_access_custom_roles and namespace_accesses are owned by the identity provider: they are neither updated nor compared with the user in Temporal Cloud. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "If true and a user with the email already exists when the resource is created, the user is adopted instead of failing: its access is set to the configured access, and it is deleted when the resource is destroyed. Only use it for users that are not managed elsewhere. Defaults to false; prefer importing existing users.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"invitation_status": schema.StringAttribute{
+				Description: "The status of the invitation sent to the user. One of pending, expired, or none if the user has no open invitation, such as after accepting it.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_expires_at": schema.StringAttribute{
+				Description: "The expiry time of the open invitation of the user in ISO 8601 format. Null if the user has no open invitation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -165,6 +215,36 @@ func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// managed_by_scim is only determined if ignore_scim_managed_fields is true.
+	if !plan.IgnoreSCIMManagedFields.IsUnknown() && state.IgnoreSCIMManagedFields.ValueBool() != plan.IgnoreSCIMManagedFields.ValueBool() {
+		managedBySCIM := types.BoolNull()
+		if plan.IgnoreSCIMManagedFields.ValueBool() {
+			managedBySCIM = types.BoolUnknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("managed_by_scim"), managedBySCIM)...)
+	}
+
+	if invitationExpired(state.InvitationStatus.ValueString(), state.InvitationExpiresAt.ValueString(), time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Expired User Invitation",
+			fmt.Sprintf("The invitation of user %s has expired, so the user cannot join the account. Send a new invitation from the Temporal Cloud UI.", state.Email.ValueString()),
+		)
+	}
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -193,34 +273,66 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	svcResp, err := r.client.CloudService().CreateUser(ctx, &cloudservicev1.CreateUserRequest{
-		Spec: &identityv1.UserSpec{
-			Email: plan.Email.ValueString(),
-			Access: &identityv1.Access{
-				AccountAccess:     accountAccess,
-				NamespaceAccesses: namespaceAccesses,
-			},
-		},
-		AsyncOperationId: uuid.New().String(),
-	})
+	access := &identityv1.Access{
+		AccountAccess:     accountAccess,
+		NamespaceAccesses: namespaceAccesses,
+	}
+
+	existingUser, err := findUserByEmail(ctx, r.client, plan.Email.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create user", err.Error())
+		resp.Diagnostics.AddError("Failed to get existing user", err.Error())
 		return
 	}
-	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.AsyncOperation); err != nil {
-		resp.Diagnostics.AddError("Failed to create user", err.Error())
+
+	var userID string
+	if existingUser != nil && !plan.AdoptExisting.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"User Already Exists",
+			fmt.Sprintf("A user with the email %s already exists with ID %s. Import it with `terraform import`, or set adopt_existing to true to adopt it. Adopted users are deleted when the resource is destroyed.", plan.Email.ValueString(), existingUser.GetId()),
+		)
 		return
 	}
+	if existingUser != nil {
+		resp.Diagnostics.AddWarning(
+			"Adopted Existing User",
+			fmt.Sprintf("A user with the email %s already exists. Terraform adopted it instead of creating a new user.", plan.Email.ValueString()),
+		)
+
+		userID = existingUser.GetId()
+		resp.Diagnostics.Append(r.updateUserAccess(ctx, &plan, existingUser, access)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		svcResp, err := r.client.CloudService().CreateUser(ctx, &cloudservicev1.CreateUserRequest{
+			Spec: &identityv1.UserSpec{
+				Email:  plan.Email.ValueString(),
+				Access: access,
+			},
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create user", err.Error())
+			return
+		}
+		if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.AsyncOperation); err != nil {
+			resp.Diagnostics.AddError("Failed to create user", err.Error())
+			return
+		}
+		userID = svcResp.UserId
+	}
 
 	user, err := r.client.CloudService().GetUser(ctx, &cloudservicev1.GetUserRequest{
-		UserId: svcResp.UserId,
+		UserId: userID,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get user after creation", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(updateUserModelFromSpec(ctx, &plan, user.User)...)
+	prior := plan
+	resp.Diagnostics.Append(r.updateUserModel(ctx, &plan, &prior, user.User)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -253,7 +365,8 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	resp.Diagnostics.Append(updateUserModelFromSpec(ctx, &state, user.User)...)
+	prior := state
+	resp.Diagnostics.Append(r.updateUserModel(ctx, &state, &prior, user.User)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -292,22 +405,8 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		NamespaceAccesses: namespaceAccesses,
 	}
 
-	svcResp, err := r.client.CloudService().UpdateUser(ctx, &cloudservicev1.UpdateUserRequest{
-		UserId: plan.ID.ValueString(),
-		Spec: &identityv1.UserSpec{
-			Email:  plan.Email.ValueString(),
-			Access: access,
-		},
-		ResourceVersion:  currentUser.GetUser().GetResourceVersion(),
-		AsyncOperationId: uuid.New().String(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update user", err.Error())
-		return
-	}
-
-	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
-		resp.Diagnostics.AddError("Failed to update user", err.Error())
+	resp.Diagnostics.Append(r.updateUserAccess(ctx, &plan, currentUser.GetUser(), access)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -319,10 +418,17 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(updateUserModelFromSpec(ctx, &plan, user.User)...)
+	prior := plan
+	resp.Diagnostics.Append(r.updateUserModel(ctx, &plan, &prior, user.User)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// The invitation can be accepted or expire between planning and applying. The planned invitation is kept,
+	// as the result has to match the plan, and the change is picked up by the next refresh.
+	if !prior.InvitationStatus.IsUnknown() {
+		plan.InvitationStatus = prior.InvitationStatus
+		plan.InvitationExpiresAt = prior.InvitationExpiresAt
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -398,6 +504,132 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// updateUserAccess sets the access of the existing user, unless the access is managed via SCIM and ignored.
+func (r *userResource) updateUserAccess(ctx context.Context, plan *userResourceModel, user *identityv1.User, access *identityv1.Access) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.IgnoreSCIMManagedFields.ValueBool() {
+		managedBySCIM, err := isUserManagedBySCIM(ctx, r.client, user.GetId())
+		if err != nil {
+			diags.AddError("Failed to get SCIM groups of user", err.Error())
+			return diags
+		}
+		if managedBySCIM {
+			return diags
+		}
+	}
+
+	svcResp, err := r.client.CloudService().UpdateUser(ctx, &cloudservicev1.UpdateUserRequest{
+		UserId: user.GetId(),
+		Spec: &identityv1.UserSpec{
+			Email:  plan.Email.ValueString(),
+			Access: access,
+		},
+		ResourceVersion:  user.GetResourceVersion(),
+		AsyncOperationId: uuid.New().String(),
+	})
+	if err != nil {
+		diags.AddError("Failed to update user", err.Error())
+		return diags
+	}
+
+	if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+		diags.AddError("Failed to update user", err.Error())
+	}
+	return diags
+}
+
+// updateUserModel updates the model from the user, keeping the fields managed via SCIM from prior if they are
+// ignored.
+func (r *userResource) updateUserModel(ctx context.Context, state *userResourceModel, prior *userResourceModel, user *identityv1.User) diag.Diagnostics {
+	diags := updateUserModelFromSpec(ctx, state, user)
+	if diags.HasError() {
+		return diags
+	}
+
+	if state.IgnoreSCIMManagedFields.IsNull() {
+		// Imported users, or users created before ignore_scim_managed_fields existed.
+		state.IgnoreSCIMManagedFields = types.BoolValue(false)
+	}
+	if state.AdoptExisting.IsNull() {
+		// Imported users, or users created before adopt_existing existed.
+		state.AdoptExisting = types.BoolValue(false)
+	}
+
+	// Whether the user is managed via SCIM is only needed to ignore the fields managed via SCIM, and costs a
+	// request per SCIM group plus a request per page of their members. It only matters if the access of the
+	// user differs from the prior access, so the prior value is kept otherwise.
+	state.ManagedBySCIM = types.BoolNull()
+	if state.IgnoreSCIMManagedFields.ValueBool() && !prior.ManagedBySCIM.IsNull() && !prior.ManagedBySCIM.IsUnknown() && !userAccessChanged(state, prior) {
+		state.ManagedBySCIM = prior.ManagedBySCIM
+	} else if state.IgnoreSCIMManagedFields.ValueBool() {
+		managedBySCIM, err := isUserManagedBySCIM(ctx, r.client, user.GetId())
+		if err != nil {
+			// Keep the previous value rather than failing, so the user can still be read.
+			diags.AddWarning(
+				"Unable to determine whether the user is managed via SCIM",
+				fmt.Sprintf("Failed to get SCIM groups of user %s: %s. The previous value of managed_by_scim is kept.", user.GetId(), err.Error()),
+			)
+			if !prior.ManagedBySCIM.IsUnknown() {
+				state.ManagedBySCIM = prior.ManagedBySCIM
+			}
+		} else {
+			state.ManagedBySCIM = types.BoolValue(managedBySCIM)
+		}
+	}
+
+	keepSCIMManagedFields(state, prior)
+	return diags
+}
+
+// userAccessChanged reports whether the access of the user in state differs from the prior access.
+func userAccessChanged(state *userResourceModel, prior *userResourceModel) bool {
+	if prior.AccountAccess.IsNull() || prior.AccountAccess.IsUnknown() ||
+		!strings.EqualFold(state.AccountAccess.ValueString(), prior.AccountAccess.ValueString()) {
+		return true
+	}
+	return !state.AccountAccessCustomRoles.Equal(prior.AccountAccessCustomRoles) || !state.NamespaceAccesses.Equal(prior.NamespaceAccesses)
+}
+
+// keepSCIMManagedFields restores the fields owned by the identity provider from prior, if the user is managed
+// via SCIM and those fields are ignored.
+func keepSCIMManagedFields(state *userResourceModel, prior *userResourceModel) {
+	if !state.IgnoreSCIMManagedFields.ValueBool() || !state.ManagedBySCIM.ValueBool() || prior.AccountAccess.IsNull() {
+		return
+	}
+
+	state.AccountAccess = prior.AccountAccess
+	state.AccountAccessCustomRoles = prior.AccountAccessCustomRoles
+	state.NamespaceAccesses = prior.NamespaceAccesses
+}
+
+// userInvitationStatus returns the status of the open invitation of the user: pending, expired, or none.
+func userInvitationStatus(user *identityv1.User, now time.Time) string {
+	if user.GetState() == resourcev1.ResourceState_RESOURCE_STATE_EXPIRED {
+		return userInvitationStatusExpired
+	}
+	if user.GetInvitation() == nil {
+		return userInvitationStatusNone
+	}
+	if expiredTime := user.GetInvitation().GetExpiredTime(); expiredTime != nil && !expiredTime.AsTime().After(now) {
+		return userInvitationStatusExpired
+	}
+	return userInvitationStatusPending
+}
+
+// invitationExpired reports whether the invitation with the given status and expiry time has expired by now.
+func invitationExpired(invitationStatus string, invitationExpiresAt string, now time.Time) bool {
+	switch invitationStatus {
+	case userInvitationStatusExpired:
+		return true
+	case userInvitationStatusPending:
+		expiresAt, err := time.Parse(time.RFC3339, invitationExpiresAt)
+		return err == nil && !expiresAt.After(now)
+	default:
+		return false
+	}
+}
+
 func getNamespaceAccessesFromModel(ctx context.Context, model *userResourceModel) (map[string]*identityv1.NamespaceAccess, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -441,6 +673,11 @@ func updateUserModelFromSpec(ctx context.Context, state *userResourceModel, user
 	}
 	state.State = types.StringValue(stateStr)
 	state.Email = types.StringValue(user.GetSpec().GetEmail())
+	state.InvitationStatus = types.StringValue(userInvitationStatus(user, time.Now()))
+	state.InvitationExpiresAt = types.StringNull()
+	if user.GetInvitation().GetExpiredTime() != nil {
+		state.InvitationExpiresAt = types.StringValue(user.GetInvitation().GetExpiredTime().AsTime().Format(time.RFC3339))
+	}
 	role, err := enums.FromAccountAccessRole(user.GetSpec().GetAccess().GetAccountAccess().GetRole())
 	if err != nil {
 		diags.AddError("Failed to convert account access role", err.Error())
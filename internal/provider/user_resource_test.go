@@ -9,14 +9,22 @@ import (
 	"regexp"
 	"testing"
 	"text/template"
+	"time"
 
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
 )
 
 const (
@@ -46,6 +54,205 @@ func TestUserSchema(t *testing.T) {
 	}
 }
 
+func TestKeepSCIMManagedFields(t *testing.T) {
+	t.Parallel()
+
+	prior := userResourceModel{
+		AccountAccess:            internaltypes.CaseInsensitiveString("developer"),
+		AccountAccessCustomRoles: types.SetNull(types.StringType),
+		NamespaceAccesses:        types.SetNull(types.ObjectType{AttrTypes: userNamespaceAccessAttrs}),
+	}
+
+	tests := []struct {
+		name          string
+		ignore        bool
+		managedBySCIM bool
+		prior         userResourceModel
+		want          string
+	}{
+		{name: "not ignored", ignore: false, managedBySCIM: true, prior: prior, want: "none"},
+		{name: "not managed by scim", ignore: true, managedBySCIM: false, prior: prior, want: "none"},
+		{name: "ignored and managed by scim", ignore: true, managedBySCIM: true, prior: prior, want: "developer"},
+		{name: "imported", ignore: true, managedBySCIM: true, prior: userResourceModel{AccountAccess: internaltypes.CaseInsensitiveStringValue{StringValue: types.StringNull()}}, want: "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := userResourceModel{
+				AccountAccess:           internaltypes.CaseInsensitiveString("none"),
+				IgnoreSCIMManagedFields: types.BoolValue(tt.ignore),
+				ManagedBySCIM:           types.BoolValue(tt.managedBySCIM),
+			}
+
+			keepSCIMManagedFields(&state, &tt.prior)
+
+			if state.AccountAccess.ValueString() != tt.want {
+				t.Errorf("account_access = %q, want %q", state.AccountAccess.ValueString(), tt.want)
+			}
+		})
+	}
+}
+
+// fakeSCIMGroupsCloudService serves a single SCIM group with the given members, or fails listing groups.
+type fakeSCIMGroupsCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	members      []string
+	failGroups   bool
+	groupsCalled bool
+}
+
+func (f *fakeSCIMGroupsCloudService) GetUserGroups(_ context.Context, _ *cloudservicev1.GetUserGroupsRequest) (*cloudservicev1.GetUserGroupsResponse, error) {
+	f.groupsCalled = true
+	if f.failGroups {
+		return nil, status.Error(codes.FailedPrecondition, "groups unavailable")
+	}
+	return &cloudservicev1.GetUserGroupsResponse{
+		Groups: []*identityv1.UserGroup{{
+			Id:   "scim-group",
+			Spec: &identityv1.UserGroupSpec{GroupType: &identityv1.UserGroupSpec_ScimGroup{ScimGroup: &identityv1.SCIMGroupSpec{}}},
+		}},
+	}, nil
+}
+
+func (f *fakeSCIMGroupsCloudService) GetUserGroupMembers(_ context.Context, _ *cloudservicev1.GetUserGroupMembersRequest) (*cloudservicev1.GetUserGroupMembersResponse, error) {
+	resp := &cloudservicev1.GetUserGroupMembersResponse{}
+	for _, userID := range f.members {
+		resp.Members = append(resp.Members, &identityv1.UserGroupMember{MemberId: userGroupMemberID(userID)})
+	}
+	return resp, nil
+}
+
+func TestUpdateUserModelManagedBySCIM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	user := &identityv1.User{
+		Id:    "user-id",
+		State: resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		Spec:  &identityv1.UserSpec{Email: "user@example.com", Access: &identityv1.Access{AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_DEVELOPER}}},
+	}
+
+	tests := []struct {
+		name        string
+		ignore      bool
+		fake        *fakeSCIMGroupsCloudService
+		prior       types.Bool
+		priorAccess string
+		want        types.Bool
+		wantLookup  bool
+		wantWarning bool
+	}{
+		{name: "not ignored", fake: &fakeSCIMGroupsCloudService{members: []string{"user-id"}}, prior: types.BoolValue(true), want: types.BoolNull()},
+		{name: "managed by scim", ignore: true, fake: &fakeSCIMGroupsCloudService{members: []string{"user-id"}}, prior: types.BoolUnknown(), want: types.BoolValue(true), wantLookup: true},
+		{name: "not managed by scim", ignore: true, fake: &fakeSCIMGroupsCloudService{members: []string{"other-user-id"}}, prior: types.BoolValue(true), want: types.BoolValue(false), wantLookup: true},
+		{name: "access unchanged", ignore: true, fake: &fakeSCIMGroupsCloudService{members: []string{"user-id"}}, prior: types.BoolValue(false), priorAccess: "Developer", want: types.BoolValue(false)},
+		{name: "access changed", ignore: true, fake: &fakeSCIMGroupsCloudService{members: []string{"user-id"}}, prior: types.BoolValue(false), priorAccess: "read", want: types.BoolValue(true), wantLookup: true},
+		{name: "lookup fails", ignore: true, fake: &fakeSCIMGroupsCloudService{failGroups: true}, prior: types.BoolValue(true), want: types.BoolValue(true), wantLookup: true, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &userResource{client: newTestClient(t, tt.fake)}
+			state := userResourceModel{
+				NamespaceAccesses:        types.SetNull(types.ObjectType{AttrTypes: userNamespaceAccessAttrs}),
+				AccountAccessCustomRoles: types.SetNull(types.StringType),
+				IgnoreSCIMManagedFields:  types.BoolValue(tt.ignore),
+			}
+			prior := userResourceModel{
+				AccountAccess:            internaltypes.CaseInsensitiveStringValue{StringValue: types.StringNull()},
+				AccountAccessCustomRoles: types.SetNull(types.StringType),
+				NamespaceAccesses:        types.SetNull(types.ObjectType{AttrTypes: userNamespaceAccessAttrs}),
+				ManagedBySCIM:            tt.prior,
+			}
+			if tt.priorAccess != "" {
+				prior.AccountAccess = internaltypes.CaseInsensitiveString(tt.priorAccess)
+			}
+
+			diags := r.updateUserModel(ctx, &state, &prior, user)
+			if diags.HasError() {
+				t.Fatalf("updateUserModel() diagnostics: %+v", diags)
+			}
+			if (diags.WarningsCount() != 0) != tt.wantWarning {
+				t.Errorf("updateUserModel() warnings = %+v, want warning %v", diags, tt.wantWarning)
+			}
+			if !state.ManagedBySCIM.Equal(tt.want) {
+				t.Errorf("managed_by_scim = %s, want %s", state.ManagedBySCIM, tt.want)
+			}
+			if tt.fake.groupsCalled != tt.wantLookup {
+				t.Errorf("listed groups = %v, want %v", tt.fake.groupsCalled, tt.wantLookup)
+			}
+		})
+	}
+}
+
+func TestUserInvitationStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		user *identityv1.User
+		want string
+	}{
+		{
+			name: "no open invitation",
+			user: &identityv1.User{State: resourcev1.ResourceState_RESOURCE_STATE_ACTIVE},
+			want: "none",
+		},
+		{
+			name: "pending invitation",
+			user: &identityv1.User{Invitation: &identityv1.Invitation{ExpiredTime: timestamppb.New(now.Add(time.Hour))}},
+			want: "pending",
+		},
+		{
+			name: "expired invitation",
+			user: &identityv1.User{Invitation: &identityv1.Invitation{ExpiredTime: timestamppb.New(now.Add(-time.Hour))}},
+			want: "expired",
+		},
+		{
+			name: "expired user",
+			user: &identityv1.User{State: resourcev1.ResourceState_RESOURCE_STATE_EXPIRED},
+			want: "expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userInvitationStatus(tt.user, now); got != tt.want {
+				t.Errorf("userInvitationStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvitationExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                string
+		invitationStatus    string
+		invitationExpiresAt string
+		want                bool
+	}{
+		{name: "no open invitation", invitationStatus: "none", want: false},
+		{name: "expired", invitationStatus: "expired", invitationExpiresAt: "2025-05-01T00:00:00Z", want: true},
+		{name: "pending", invitationStatus: "pending", invitationExpiresAt: "2025-07-01T00:00:00Z", want: false},
+		{name: "pending but expired since last read", invitationStatus: "pending", invitationExpiresAt: "2025-05-31T00:00:00Z", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invitationExpired(tt.invitationStatus, tt.invitationExpiresAt, now); got != tt.want {
+				t.Errorf("invitationExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func createRandomEmail() string {
 	return fmt.Sprintf("%s+terraformprovider-%s@%s", emailBaseAddr, randomString(10), emailDomain)
 }
@@ -72,6 +279,7 @@ resource "temporalcloud_user" "terraform" {
 		Steps: []resource.TestStep{
 			{
 				Config: config(emailAddr, "read"),
+				Check:  resource.TestCheckResourceAttr("temporalcloud_user.terraform", "invitation_status", "pending"),
 			},
 			{
 				Config: config(emailAddr, "developer"),
@@ -88,6 +296,51 @@ resource "temporalcloud_user" "terraform" {
 	})
 }
 
+func TestAccUserAdoptExisting(t *testing.T) {
+	emailAddr := createRandomEmail()
+	config := func(email string, adopt string) string {
+		duplicate := ""
+		if adopt != "" {
+			duplicate = fmt.Sprintf(`
+resource "temporalcloud_user" "duplicate" {
+  email          = temporalcloud_user.terraform.email
+  account_access = "read"
+  adopt_existing = %s
+}`, adopt)
+		}
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_user" "terraform" {
+  email          = "%s"
+  account_access = "read"
+}
+%s`, email, duplicate)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(emailAddr, ""),
+			},
+			{
+				Config:      config(emailAddr, "false"),
+				ExpectError: regexp.MustCompile("User Already Exists"),
+			},
+			{
+				Config: config(emailAddr, "true"),
+				Check:  resource.TestCheckResourceAttrPair("temporalcloud_user.duplicate", "id", "temporalcloud_user.terraform", "id"),
+			},
+		},
+	})
+}
+
 func TestAccBasicUserWithNamespaceAccesses(t *testing.T) {
 	type configArgs struct {
 		Email         string
@@ -3,20 +3,35 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
 	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
 
 	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
 )
 
 type (
 	usersDataSource struct {
 		client *client.Client
 	}
+
+	// userFilters holds the filters of the users data source that are applied client-side. Empty filters
+	// match every user.
+	userFilters struct {
+		email         string
+		emailRegex    *regexp.Regexp
+		accountAccess string
+		state         string
+	}
 )
 
 var (
@@ -51,12 +66,35 @@ func (d *usersDataSource) Configure(ctx context.Context, req datasource.Configur
 
 func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches details about all Users.",
+		Description: "Fetches details about all Users, optionally filtered.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the Users data source.",
 				Computed:    true,
 			},
+			"email": schema.StringAttribute{
+				Description: "If set, only the user with this email, compared case-insensitively, is returned.",
+				Optional:    true,
+			},
+			"email_regex": schema.StringAttribute{
+				Description: "If set, only users whose email matches this regular expression are returned.",
+				Optional:    true,
+			},
+			"account_access": schema.StringAttribute{
+				Description: "If set, only users with this role on the account are returned. Must be one of owner, admin, developer, read, financeadmin, metricsread, or none for users without a role (case-insensitive).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(append(enums.AllowedAccountAccessRoles(), "none")...),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Description: "If set, only users with access to this namespace are returned.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "If set, only users in this state are returned, such as active.",
+				Optional:    true,
+			},
 			"users": schema.ListNestedAttribute{
 				Description: "The list of Users.",
 				Computed:    true,
@@ -70,26 +108,44 @@ func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 
 func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state usersDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	var users []*identityv1.User
-	pageToken := ""
-	for {
-		r, err := d.client.CloudService().GetUsers(ctx, &cloudservicev1.GetUsersRequest{PageToken: pageToken})
+	filters := userFilters{
+		email:         state.Email.ValueString(),
+		accountAccess: state.AccountAccess.ValueString(),
+		state:         state.State.ValueString(),
+	}
+	if !state.EmailRegex.IsNull() {
+		emailRegex, err := regexp.Compile(state.EmailRegex.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to fetch users", err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("email_regex"), "Invalid email_regex", err.Error())
 			return
 		}
+		filters.emailRegex = emailRegex
+	}
 
-		users = append(users, r.GetUsers()...)
-
-		if r.GetNextPageToken() == "" {
-			break
-		}
-
-		pageToken = r.GetNextPageToken()
+	// The email and namespace filters are applied server-side, the email is checked again client-side to
+	// compare it case-insensitively.
+	users, err := getUsersByEmail(ctx, d.client, state.Email.ValueString(), state.NamespaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to fetch users", err.Error())
+		return
 	}
 
+	state.Users = make([]userDataModel, 0, len(users))
 	for _, sa := range users {
+		matches, err := filters.matches(sa)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to filter users", err.Error())
+			return
+		}
+		if !matches {
+			continue
+		}
+
 		userModel, diags := userToUserDataModel(ctx, sa)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -109,3 +165,33 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// matches reports whether the user matches all of the filters.
+func (f *userFilters) matches(user *identityv1.User) (bool, error) {
+	email := user.GetSpec().GetEmail()
+	if f.email != "" && !strings.EqualFold(email, f.email) {
+		return false, nil
+	}
+	if f.emailRegex != nil && !f.emailRegex.MatchString(email) {
+		return false, nil
+	}
+	if f.state != "" {
+		state, err := enums.FromResourceState(user.GetState())
+		if err != nil {
+			return false, err
+		}
+		if !strings.EqualFold(state, f.state) {
+			return false, nil
+		}
+	}
+	if f.accountAccess != "" {
+		role, err := enums.FromAccountAccessRole(user.GetSpec().GetAccess().GetAccountAccess().GetRole())
+		if err != nil {
+			return false, err
+		}
+		if !strings.EqualFold(role, f.accountAccess) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	resourcev1 "go.temporal.io/cloud-sdk/api/resource/v1"
 )
 
 func TestAccUsers(t *testing.T) {
@@ -29,3 +36,153 @@ provider "temporalcloud" {
 data "temporalcloud_users" "example" {}
 `
 }
+
+func TestUserFiltersMatches(t *testing.T) {
+	t.Parallel()
+
+	user := &identityv1.User{
+		State: resourcev1.ResourceState_RESOURCE_STATE_ACTIVE,
+		Spec: &identityv1.UserSpec{
+			Email: "Jane.Doe@example.com",
+			Access: &identityv1.Access{
+				AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_DEVELOPER},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filters userFilters
+		want    bool
+	}{
+		{"no filters", userFilters{}, true},
+		{"email match", userFilters{email: "jane.doe@EXAMPLE.com"}, true},
+		{"email mismatch", userFilters{email: "john.doe@example.com"}, false},
+		{"email regex match", userFilters{emailRegex: regexp.MustCompile("@example\\.com$")}, true},
+		{"email regex mismatch", userFilters{emailRegex: regexp.MustCompile("@example\\.org$")}, false},
+		{"account access match", userFilters{accountAccess: "DEVELOPER"}, true},
+		{"account access mismatch", userFilters{accountAccess: "admin"}, false},
+		{"state match", userFilters{state: "active"}, true},
+		{"state mismatch", userFilters{state: "deleting"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.matches(user)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// fakeCaseSensitiveUsersCloudService serves users, filtering them by email case-sensitively.
+type fakeCaseSensitiveUsersCloudService struct {
+	cloudservicev1.UnimplementedCloudServiceServer
+
+	users    []*identityv1.User
+	requests int
+}
+
+func (f *fakeCaseSensitiveUsersCloudService) GetUsers(_ context.Context, req *cloudservicev1.GetUsersRequest) (*cloudservicev1.GetUsersResponse, error) {
+	f.requests++
+	resp := &cloudservicev1.GetUsersResponse{}
+	for _, user := range f.users {
+		if req.GetEmail() == "" || user.GetSpec().GetEmail() == req.GetEmail() {
+			resp.Users = append(resp.Users, user)
+		}
+	}
+	return resp, nil
+}
+
+func TestFindUserByEmail(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	users := []*identityv1.User{
+		{Id: "user-1", Spec: &identityv1.UserSpec{Email: "Jane.Doe@example.com"}},
+		{Id: "user-2", Spec: &identityv1.UserSpec{Email: "john@example.com"}},
+	}
+
+	tests := []struct {
+		name         string
+		email        string
+		wantID       string
+		wantRequests int
+	}{
+		{name: "exact email", email: "john@example.com", wantID: "user-2", wantRequests: 1},
+		{name: "email in other case", email: "jane.doe@example.com", wantID: "user-1", wantRequests: 2},
+		{name: "unknown email", email: "unknown@example.com", wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeCaseSensitiveUsersCloudService{users: users}
+			user, err := findUserByEmail(ctx, newTestClient(t, fake), tt.email)
+			if err != nil {
+				t.Fatalf("findUserByEmail() error = %v", err)
+			}
+			if user.GetId() != tt.wantID {
+				t.Errorf("findUserByEmail() = %q, want %q", user.GetId(), tt.wantID)
+			}
+			if fake.requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", fake.requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestAccUsersFilters(t *testing.T) {
+	email := createRandomEmail()
+	config := fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_user" "terraform" {
+  email          = "%[1]s"
+  account_access = "read"
+}
+
+data "temporalcloud_users" "by_email" {
+  email          = upper(temporalcloud_user.terraform.email)
+  account_access = "read"
+}
+
+data "temporalcloud_users" "by_email_regex" {
+  email_regex = "^%[2]s$"
+
+  depends_on = [temporalcloud_user.terraform]
+}
+
+data "temporalcloud_users" "by_email_regex_and_account_access" {
+  email_regex    = "^%[2]s$"
+  account_access = "admin"
+
+  depends_on = [temporalcloud_user.terraform]
+}
+`, email, strings.ReplaceAll(regexp.QuoteMeta(email), `\`, `\\`))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporalcloud_users.by_email", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.temporalcloud_users.by_email", "users.0.email", email),
+					resource.TestCheckResourceAttr("data.temporalcloud_users.by_email_regex", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.temporalcloud_users.by_email_regex_and_account_access", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,864 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	cloudservicev1 "go.temporal.io/cloud-sdk/api/cloudservice/v1"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/client"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/provider/enums"
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
+	"github.com/temporalio/terraform-provider-temporalcloud/internal/validation"
+)
+
+const (
+	usersRosterCreate usersRosterChangeKind = iota
+	usersRosterUpdate
+	usersRosterDelete
+)
+
+type (
+	usersRosterResource struct {
+		client *client.Client
+	}
+
+	usersRosterResourceModel struct {
+		ID                 types.String `tfsdk:"id"`
+		Users              types.Set    `tfsdk:"users"`
+		MaxConcurrency     types.Int64  `tfsdk:"max_concurrency"`
+		DeleteAdoptedUsers types.Bool   `tfsdk:"delete_adopted_users"`
+		UserIDs            types.Map    `tfsdk:"user_ids"`
+		AdoptedUserIDs     types.Set    `tfsdk:"adopted_user_ids"`
+
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	usersRosterUserModel struct {
+		Email             types.String                             `tfsdk:"email"`
+		AccountAccess     internaltypes.CaseInsensitiveStringValue `tfsdk:"account_access"`
+		NamespaceAccesses types.Set                                `tfsdk:"namespace_accesses"`
+	}
+
+	usersRosterChangeKind int
+
+	// usersRosterChange is a change to a single user of the roster. Users are keyed by their lower-cased email.
+	usersRosterChange struct {
+		kind            usersRosterChangeKind
+		key             string
+		email           string
+		userID          string
+		resourceVersion string
+		access          *identityv1.Access
+	}
+
+	usersRosterResult struct {
+		userID string
+		err    error
+	}
+)
+
+var (
+	_ resource.Resource               = (*usersRosterResource)(nil)
+	_ resource.ResourceWithConfigure  = (*usersRosterResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*usersRosterResource)(nil)
+
+	usersRosterUserAttrs = map[string]attr.Type{
+		"email":              types.StringType,
+		"account_access":     internaltypes.CaseInsensitiveStringType{},
+		"namespace_accesses": types.SetType{ElemType: types.ObjectType{AttrTypes: namespaceAccessAttrs}},
+	}
+)
+
+func NewUsersRosterResource() resource.Resource {
+	return &usersRosterResource{}
+}
+
+func (r *usersRosterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *usersRosterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users_roster"
+}
+
+func (r *usersRosterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages many Temporal Cloud users at once, such as a roster decoded from a CSV or YAML file. Users of the roster that already exist are adopted. Users removed from the roster are deleted, except for adopted users unless delete_adopted_users is true. If some users cannot be changed, the other users are still changed and an error is reported for each failed user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the roster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				Description: "The users of the roster.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "The email address for the user. Must be unique across the roster, ignoring case.",
+							Required:    true,
+						},
+						"account_access": schema.StringAttribute{
+							CustomType:  internaltypes.CaseInsensitiveStringType{},
+							Description: "The role on the account. Must be one of `owner`, `admin`, `developer`, `read`, `financeadmin`, `metricsread`, or `none` (case-insensitive). `owner` is only valid for adopting existing owners, as owners cannot be created, updated or deleted without Temporal support. `none` grants no account role and is only valid for existing users, such as users invited by a `temporalcloud_group_members` resource or managed via SCIM.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(append(enums.AllowedAccountAccessRoles(), "none")...),
+							},
+						},
+						"namespace_accesses": schema.SetNestedAttribute{
+							Description: "The set of namespace accesses. Empty sets are not allowed, omit the attribute instead.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"namespace_id": schema.StringAttribute{
+										Description: "The namespace to assign permissions to.",
+										Required:    true,
+									},
+									"permission": schema.StringAttribute{
+										CustomType:  internaltypes.CaseInsensitiveStringType{},
+										Description: "The permission to assign. Must be one of admin, write, or read (case-insensitive)",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOfCaseInsensitive(enums.AllowedNamespaceAccessPermissions()...),
+										},
+									},
+								},
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								validation.SetNestedAttributeMustBeUnique("namespace_id"),
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					validation.SetNestedAttributeMustBeUniqueCaseInsensitive("email"),
+				},
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Description: "The maximum number of users changed at the same time. Defaults to 5.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"delete_adopted_users": schema.BoolAttribute{
+				Description: "Whether to delete adopted users, that is users that already existed when they were added to the roster, when they are removed from the roster or the roster is destroyed. Defaults to false, which leaves adopted users in place with their current access.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"user_ids": schema.MapAttribute{
+				Description: "The IDs of the users of the roster, keyed by email.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"adopted_user_ids": schema.SetAttribute{
+				Description: "The IDs of the users of the roster that already existed when they were added to the roster.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *usersRosterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var users types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("users"), &users)...)
+	if resp.Diagnostics.HasError() || users.IsUnknown() {
+		return
+	}
+	for _, element := range users.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok || obj.IsUnknown() || obj.Attributes()["email"].IsUnknown() || obj.Attributes()["account_access"].IsUnknown() {
+			return
+		}
+	}
+	plannedUsers, d := getUsersRosterUsers(ctx, users)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorIDs := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var userIDs types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("user_ids"), &userIDs)...)
+		priorIDs, d = getUsersRosterUserIDs(ctx, userIDs)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Only users that are new to the roster may not exist yet, and only none and owner cannot be created.
+	var candidates []string
+	for _, key := range sortedKeys(plannedUsers) {
+		if _, ok := priorIDs[key]; ok {
+			continue
+		}
+		if !usersRosterAccountAccessCreatable(plannedUsers[key].AccountAccess.ValueString()) {
+			candidates = append(candidates, key)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	byID, err := getAllUsersByID(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Roster Users",
+			fmt.Sprintf("Failed to look up users from Temporal Cloud API: %s. Users with an account_access of none or owner that do not exist fail when applying.", err.Error()),
+		)
+		return
+	}
+	existing := make(map[string]bool, len(byID))
+	for _, user := range byID {
+		existing[strings.ToLower(user.GetSpec().GetEmail())] = true
+	}
+	for _, key := range candidates {
+		if existing[key] {
+			continue
+		}
+		model := plannedUsers[key]
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Invalid Roster User",
+			fmt.Sprintf("User %s does not exist, so it cannot be created with an account_access of %s. Owners cannot be created, and none is only valid for existing users.", model.Email.ValueString(), strings.ToLower(model.AccountAccess.ValueString())),
+		)
+	}
+}
+
+// usersRosterAccountAccessCreatable reports whether users can be created with the account access role.
+func usersRosterAccountAccessCreatable(accountAccess string) bool {
+	return !strings.EqualFold(accountAccess, "none") && !strings.EqualFold(accountAccess, "owner")
+}
+
+func (r *usersRosterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan usersRosterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.ID = types.StringValue(uuid.New().String())
+	resp.Diagnostics.Append(r.syncUsers(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		// Users that were created are adopted when the roster is created again, so the partial result is
+		// not stored. Storing it would taint the roster and delete those users on the next apply. The
+		// adopted users are then only deleted when delete_adopted_users is true.
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *usersRosterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state usersRosterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorUsers, d := getUsersRosterUsers(ctx, state.Users)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	priorIDs, d := getUsersRosterUserIDs(ctx, state.UserIDs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorAdopted, d := getUsersRosterAdoptedUserIDs(ctx, state.AdoptedUserIDs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getAllUsersByID(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get users", err.Error())
+		return
+	}
+
+	users := make([]attr.Value, 0, len(priorUsers))
+	userIDs := make(map[string]attr.Value, len(priorUsers))
+	var adoptedIDs []string
+	for key, model := range priorUsers {
+		user, ok := current[priorIDs[key]]
+		if !ok {
+			// The user was deleted outside of Terraform.
+			continue
+		}
+
+		obj, d := usersRosterUserFromUser(ctx, model.Email.ValueString(), user)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		users = append(users, obj)
+		userIDs[model.Email.ValueString()] = types.StringValue(user.GetId())
+		if priorAdopted[user.GetId()] {
+			adoptedIDs = append(adoptedIDs, user.GetId())
+		}
+	}
+
+	resp.Diagnostics.Append(setUsersRosterModel(&state, users, userIDs, adoptedIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.DeleteAdoptedUsers.IsNull() {
+		// Rosters created before delete_adopted_users existed.
+		state.DeleteAdoptedUsers = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *usersRosterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state usersRosterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Failed users are reported as errors, and the state records what was changed so they are retried on
+	// the next apply.
+	resp.Diagnostics.Append(r.syncUsers(ctx, &plan, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *usersRosterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state usersRosterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	plan := state
+	plan.Users = types.SetNull(types.ObjectType{AttrTypes: usersRosterUserAttrs})
+	resp.Diagnostics.Append(r.syncUsers(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		// Keep the users that could not be deleted in the state.
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}
+}
+
+// syncUsers changes the users in Temporal Cloud to match the users of plan, deleting the users of prior that
+// are no longer in plan. It sets the users and user_ids of plan to the users that were changed successfully.
+func (r *usersRosterResource) syncUsers(ctx context.Context, plan *usersRosterResourceModel, prior *usersRosterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plannedUsers, d := getUsersRosterUsers(ctx, plan.Users)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	plannedAccess := make(map[string]*identityv1.Access, len(plannedUsers))
+	for key, model := range plannedUsers {
+		access, d := getUsersRosterAccess(ctx, model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		plannedAccess[key] = access
+	}
+
+	priorUsers := map[string]usersRosterUserModel{}
+	priorIDs := map[string]string{}
+	priorAdopted := map[string]bool{}
+	if prior != nil {
+		priorUsers, d = getUsersRosterUsers(ctx, prior.Users)
+		diags.Append(d...)
+		priorIDs, d = getUsersRosterUserIDs(ctx, prior.UserIDs)
+		diags.Append(d...)
+		priorAdopted, d = getUsersRosterAdoptedUserIDs(ctx, prior.AdoptedUserIDs)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	retained := map[string]bool{}
+	if !plan.DeleteAdoptedUsers.ValueBool() {
+		retained = priorAdopted
+	}
+
+	byID, err := getAllUsersByID(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to get users", err.Error())
+		return diags
+	}
+	current := make(map[string]*identityv1.User, len(byID))
+	for _, user := range byID {
+		current[strings.ToLower(user.GetSpec().GetEmail())] = user
+	}
+
+	emails := make(map[string]string, len(plannedUsers))
+	for key, model := range plannedUsers {
+		emails[key] = model.Email.ValueString()
+	}
+	changes := planUsersRosterChanges(emails, plannedAccess, priorIDs, current, retained)
+	results := applyUsersRosterChanges(ctx, changes, int(plan.MaxConcurrency.ValueInt64()), r.applyUsersRosterChange)
+
+	users := make([]attr.Value, 0, len(plannedUsers))
+	userIDs := make(map[string]attr.Value, len(plannedUsers))
+	var adoptedIDs []string
+	keep := func(key string, email string, model usersRosterUserModel, userID string) {
+		obj, d := types.ObjectValueFrom(ctx, usersRosterUserAttrs, model)
+		diags.Append(d...)
+		users = append(users, obj)
+		userIDs[email] = types.StringValue(userID)
+		// Users keep whether they were adopted while they stay in the roster. Users new to the roster are
+		// adopted if they already existed.
+		if priorAdopted[userID] || (priorIDs[key] != userID && current[key].GetId() == userID) {
+			adoptedIDs = append(adoptedIDs, userID)
+		}
+	}
+	keepCurrent := func(key string, email string) {
+		user, ok := current[key]
+		if !ok {
+			return
+		}
+		obj, d := usersRosterUserFromUser(ctx, email, user)
+		diags.Append(d...)
+		users = append(users, obj)
+		userIDs[email] = types.StringValue(user.GetId())
+		if priorAdopted[user.GetId()] || priorIDs[key] != user.GetId() {
+			adoptedIDs = append(adoptedIDs, user.GetId())
+		}
+	}
+
+	for _, key := range sortedKeys(plannedUsers) {
+		model := plannedUsers[key]
+		email := model.Email.ValueString()
+		result, changed := results[key]
+		switch {
+		case !changed:
+			keep(key, email, model, current[key].GetId())
+		case result.err == nil:
+			keep(key, email, model, result.userID)
+		default:
+			diags.AddAttributeError(path.Root("users"), "Failed to apply user of roster", fmt.Sprintf("%s: %s", email, result.err.Error()))
+			keepCurrent(key, email)
+		}
+	}
+	var released []string
+	for _, key := range sortedKeys(priorUsers) {
+		if _, ok := plannedUsers[key]; ok {
+			continue
+		}
+		email := priorUsers[key].Email.ValueString()
+		result, changed := results[key]
+		if !changed {
+			if retained[priorIDs[key]] {
+				released = append(released, email)
+			}
+			continue
+		}
+		if result.err == nil {
+			continue
+		}
+		diags.AddAttributeError(path.Root("users"), "Failed to delete user of roster", fmt.Sprintf("%s: %s", email, result.err.Error()))
+		keepCurrent(key, email)
+	}
+	if len(released) > 0 {
+		diags.AddWarning(
+			"Adopted Users Not Deleted",
+			fmt.Sprintf("Users %s were removed from the roster but not deleted, as they already existed when they were added to the roster. Set delete_adopted_users to true to delete them.", strings.Join(released, ", ")),
+		)
+	}
+
+	diags.Append(setUsersRosterModel(plan, users, userIDs, adoptedIDs)...)
+	return diags
+}
+
+func (r *usersRosterResource) applyUsersRosterChange(ctx context.Context, change usersRosterChange) (string, error) {
+	switch change.kind {
+	case usersRosterCreate:
+		svcResp, err := r.client.CloudService().CreateUser(ctx, &cloudservicev1.CreateUserRequest{
+			Spec: &identityv1.UserSpec{
+				Email:  change.email,
+				Access: change.access,
+			},
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			return "", err
+		}
+		if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+			return "", err
+		}
+		return svcResp.GetUserId(), nil
+	case usersRosterUpdate:
+		svcResp, err := r.client.CloudService().UpdateUser(ctx, &cloudservicev1.UpdateUserRequest{
+			UserId: change.userID,
+			Spec: &identityv1.UserSpec{
+				Email:  change.email,
+				Access: change.access,
+			},
+			ResourceVersion:  change.resourceVersion,
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			return "", err
+		}
+		if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+			return "", err
+		}
+		return change.userID, nil
+	case usersRosterDelete:
+		svcResp, err := r.client.CloudService().DeleteUser(ctx, &cloudservicev1.DeleteUserRequest{
+			UserId:           change.userID,
+			ResourceVersion:  change.resourceVersion,
+			AsyncOperationId: uuid.New().String(),
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return change.userID, nil
+			}
+			return "", err
+		}
+		if err := client.AwaitAsyncOperation(ctx, r.client, svcResp.GetAsyncOperation()); err != nil {
+			return "", err
+		}
+		return change.userID, nil
+	default:
+		return "", fmt.Errorf("unknown roster change %d", change.kind)
+	}
+}
+
+// planUsersRosterChanges returns the changes needed for the current users to match the planned access. Users
+// that already exist are updated rather than created, and only users of the roster that are no longer planned
+// are deleted, unless their ID is retained. The maps other than retained are keyed by lower-cased email.
+func planUsersRosterChanges(emails map[string]string, planned map[string]*identityv1.Access, priorIDs map[string]string, current map[string]*identityv1.User, retained map[string]bool) []usersRosterChange {
+	var changes []usersRosterChange
+	for _, key := range sortedKeys(planned) {
+		access := planned[key]
+		user, ok := current[key]
+		if !ok {
+			changes = append(changes, usersRosterChange{kind: usersRosterCreate, key: key, email: emails[key], access: access})
+			continue
+		}
+		if usersRosterAccessEqual(user.GetSpec().GetAccess(), access) {
+			continue
+		}
+		if access.GetAccountAccess() != nil && len(user.GetSpec().GetAccess().GetAccountAccess().GetCustomRoles()) > 0 {
+			// Custom roles are not managed by the roster, keep the ones the user already has.
+			access.AccountAccess.CustomRoles = user.GetSpec().GetAccess().GetAccountAccess().GetCustomRoles()
+		}
+		changes = append(changes, usersRosterChange{
+			kind:            usersRosterUpdate,
+			key:             key,
+			email:           user.GetSpec().GetEmail(),
+			userID:          user.GetId(),
+			resourceVersion: user.GetResourceVersion(),
+			access:          access,
+		})
+	}
+
+	for _, key := range sortedKeys(priorIDs) {
+		if _, ok := planned[key]; ok {
+			continue
+		}
+		user, ok := current[key]
+		if !ok || user.GetId() != priorIDs[key] || retained[user.GetId()] {
+			continue
+		}
+		changes = append(changes, usersRosterChange{
+			kind:            usersRosterDelete,
+			key:             key,
+			email:           user.GetSpec().GetEmail(),
+			userID:          user.GetId(),
+			resourceVersion: user.GetResourceVersion(),
+		})
+	}
+
+	return changes
+}
+
+// applyUsersRosterChanges applies the changes with at most maxConcurrency changes at the same time, and returns
+// the result of each change keyed by the key of the change. A failed change does not stop the others.
+func applyUsersRosterChanges(
+	ctx context.Context,
+	changes []usersRosterChange,
+	maxConcurrency int,
+	applyFn func(context.Context, usersRosterChange) (string, error),
+) map[string]usersRosterResult {
+	results := make(map[string]usersRosterResult, len(changes))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(maxConcurrency, 1))
+	for _, change := range changes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(change usersRosterChange) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			userID, err := applyFn(ctx, change)
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[change.key] = usersRosterResult{userID: userID, err: err}
+		}(change)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func usersRosterAccessEqual(current *identityv1.Access, planned *identityv1.Access) bool {
+	if current.GetAccountAccess().GetRole() != planned.GetAccountAccess().GetRole() {
+		return false
+	}
+	if len(current.GetNamespaceAccesses()) != len(planned.GetNamespaceAccesses()) {
+		return false
+	}
+	for ns, access := range planned.GetNamespaceAccesses() {
+		if current.GetNamespaceAccesses()[ns].GetPermission() != access.GetPermission() {
+			return false
+		}
+	}
+	return true
+}
+
+func getUsersRosterAccess(ctx context.Context, model usersRosterUserModel) (*identityv1.Access, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accountAccess, d := getAccountAccessFromModel(ctx, model.AccountAccess.ValueString(), types.SetNull(types.StringType))
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	namespaceAccesses, d := getNamespaceAccessesFromSet(ctx, model.NamespaceAccesses)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &identityv1.Access{
+		AccountAccess:     accountAccess,
+		NamespaceAccesses: namespaceAccesses,
+	}, diags
+}
+
+// getUsersRosterUsers returns the users of the set, keyed by lower-cased email.
+func getUsersRosterUsers(ctx context.Context, set types.Set) (map[string]usersRosterUserModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users := make(map[string]usersRosterUserModel, len(set.Elements()))
+	for _, element := range set.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok {
+			diags.AddError("Failed to convert roster user", fmt.Sprintf("unexpected element type %T", element))
+			return nil, diags
+		}
+
+		var model usersRosterUserModel
+		diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		users[strings.ToLower(model.Email.ValueString())] = model
+	}
+
+	return users, diags
+}
+
+// getUsersRosterUserIDs returns the user IDs of the map, keyed by lower-cased email.
+func getUsersRosterUserIDs(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	userIDs := make(map[string]string, len(m.Elements()))
+	if m.IsNull() || m.IsUnknown() {
+		return userIDs, diags
+	}
+
+	var byEmail map[string]string
+	diags.Append(m.ElementsAs(ctx, &byEmail, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for email, userID := range byEmail {
+		userIDs[strings.ToLower(email)] = userID
+	}
+
+	return userIDs, diags
+}
+
+// getUsersRosterAdoptedUserIDs returns the user IDs of the set.
+func getUsersRosterAdoptedUserIDs(ctx context.Context, set types.Set) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	adopted := make(map[string]bool, len(set.Elements()))
+	if set.IsNull() || set.IsUnknown() {
+		return adopted, diags
+	}
+
+	var userIDs []string
+	diags.Append(set.ElementsAs(ctx, &userIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, userID := range userIDs {
+		adopted[userID] = true
+	}
+
+	return adopted, diags
+}
+
+func usersRosterUserFromUser(ctx context.Context, email string, user *identityv1.User) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	role, err := enums.FromAccountAccessRole(user.GetSpec().GetAccess().GetAccountAccess().GetRole())
+	if err != nil {
+		diags.AddError("Failed to convert account access role", err.Error())
+		return types.ObjectNull(usersRosterUserAttrs), diags
+	}
+
+	namespaceAccesses, d := getNamespaceSetFromSpec(ctx, user.GetSpec().GetAccess())
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(usersRosterUserAttrs), diags
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, usersRosterUserAttrs, usersRosterUserModel{
+		Email:             types.StringValue(email),
+		AccountAccess:     internaltypes.CaseInsensitiveString(role),
+		NamespaceAccesses: namespaceAccesses,
+	})
+	diags.Append(d...)
+	return obj, diags
+}
+
+func setUsersRosterModel(model *usersRosterResourceModel, users []attr.Value, userIDs map[string]attr.Value, adoptedIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usersSet, d := types.SetValue(types.ObjectType{AttrTypes: usersRosterUserAttrs}, users)
+	diags.Append(d...)
+	userIDsMap, d := types.MapValue(types.StringType, userIDs)
+	diags.Append(d...)
+	adopted := make([]attr.Value, 0, len(adoptedIDs))
+	for _, userID := range adoptedIDs {
+		adopted = append(adopted, types.StringValue(userID))
+	}
+	adoptedSet, d := types.SetValue(types.StringType, adopted)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Users = usersSet
+	model.UserIDs = userIDsMap
+	model.AdoptedUserIDs = adoptedSet
+	return diags
+}
+
+func getAllUsersByID(ctx context.Context, c *client.Client) (map[string]*identityv1.User, error) {
+	users := map[string]*identityv1.User{}
+	req := &cloudservicev1.GetUsersRequest{}
+	for {
+		r, err := c.CloudService().GetUsers(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range r.GetUsers() {
+			users[user.GetId()] = user
+		}
+
+		if r.GetNextPageToken() == "" {
+			return users, nil
+		}
+
+		req.PageToken = r.GetNextPageToken()
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
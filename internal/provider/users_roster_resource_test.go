@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	identityv1 "go.temporal.io/cloud-sdk/api/identity/v1"
+
+	internaltypes "github.com/temporalio/terraform-provider-temporalcloud/internal/types"
+)
+
+func TestUsersRosterSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	NewUsersRosterResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestUsersRosterSchemaValidators(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewUsersRosterResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	usersAttr := schemaResponse.Schema.Attributes["users"].(schema.SetNestedAttribute)
+	accountAccessAttr := usersAttr.NestedObject.Attributes["account_access"].(schema.StringAttribute)
+
+	// Read stores the roles of existing users, including owner and none, which must be accepted as well.
+	for _, role := range []string{"owner", "Admin", "developer", "read", "financeadmin", "metricsread", "none"} {
+		resp := &validator.StringResponse{}
+		for _, v := range accountAccessAttr.Validators {
+			v.ValidateString(ctx, validator.StringRequest{Path: path.Root("account_access"), ConfigValue: types.StringValue(role)}, resp)
+		}
+		if resp.Diagnostics.HasError() {
+			t.Errorf("account_access %q: unexpected error %+v", role, resp.Diagnostics)
+		}
+	}
+	resp := &validator.StringResponse{}
+	for _, v := range accountAccessAttr.Validators {
+		v.ValidateString(ctx, validator.StringRequest{Path: path.Root("account_access"), ConfigValue: types.StringValue("superuser")}, resp)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("account_access %q: expected an error", "superuser")
+	}
+
+	rosterUser := func(email string) attr.Value {
+		return types.ObjectValueMust(usersRosterUserAttrs, map[string]attr.Value{
+			"email":              types.StringValue(email),
+			"account_access":     internaltypes.CaseInsensitiveString("read"),
+			"namespace_accesses": types.SetNull(types.ObjectType{AttrTypes: namespaceAccessAttrs}),
+		})
+	}
+	validateUsers := func(emails ...string) diag.Diagnostics {
+		var users []attr.Value
+		for _, email := range emails {
+			users = append(users, rosterUser(email))
+		}
+		resp := &validator.SetResponse{}
+		for _, v := range usersAttr.Validators {
+			v.ValidateSet(ctx, validator.SetRequest{
+				Path:        path.Root("users"),
+				ConfigValue: types.SetValueMust(types.ObjectType{AttrTypes: usersRosterUserAttrs}, users),
+			}, resp)
+		}
+		return resp.Diagnostics
+	}
+	if diags := validateUsers("a@example.com", "b@example.com"); diags.HasError() {
+		t.Errorf("unexpected error for distinct emails: %+v", diags)
+	}
+	if diags := validateUsers("a@example.com", "A@Example.com"); !diags.HasError() {
+		t.Errorf("expected an error for emails that only differ in case")
+	}
+}
+
+func TestPlanUsersRosterChanges(t *testing.T) {
+	t.Parallel()
+
+	readAccess := &identityv1.Access{
+		AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_READ},
+	}
+	developerAccess := &identityv1.Access{
+		AccountAccess: &identityv1.AccountAccess{Role: identityv1.AccountAccess_ROLE_DEVELOPER},
+	}
+	user := func(id, email string, access *identityv1.Access) *identityv1.User {
+		return &identityv1.User{Id: id, Spec: &identityv1.UserSpec{Email: email, Access: access}}
+	}
+
+	changes := planUsersRosterChanges(
+		map[string]string{
+			"new@example.com":       "New@example.com",
+			"unchanged@example.com": "unchanged@example.com",
+			"changed@example.com":   "changed@example.com",
+		},
+		map[string]*identityv1.Access{
+			"new@example.com":       readAccess,
+			"unchanged@example.com": readAccess,
+			"changed@example.com":   developerAccess,
+		},
+		map[string]string{
+			"unchanged@example.com": "unchanged-id",
+			"removed@example.com":   "removed-id",
+			"replaced@example.com":  "old-replaced-id",
+			"adopted@example.com":   "adopted-id",
+		},
+		map[string]*identityv1.User{
+			"unchanged@example.com": user("unchanged-id", "unchanged@example.com", readAccess),
+			"changed@example.com":   user("changed-id", "changed@example.com", readAccess),
+			"removed@example.com":   user("removed-id", "removed@example.com", readAccess),
+			"replaced@example.com":  user("new-replaced-id", "replaced@example.com", readAccess),
+			"other@example.com":     user("other-id", "other@example.com", readAccess),
+			"adopted@example.com":   user("adopted-id", "adopted@example.com", readAccess),
+		},
+		map[string]bool{"adopted-id": true},
+	)
+
+	want := []usersRosterChange{
+		{kind: usersRosterUpdate, key: "changed@example.com", userID: "changed-id"},
+		{kind: usersRosterCreate, key: "new@example.com", email: "New@example.com"},
+		{kind: usersRosterDelete, key: "removed@example.com", userID: "removed-id"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(want), len(changes), changes)
+	}
+	for i, change := range changes {
+		if change.kind != want[i].kind || change.key != want[i].key || change.userID != want[i].userID {
+			t.Errorf("change %d = %+v, want %+v", i, change, want[i])
+		}
+		if want[i].email != "" && change.email != want[i].email {
+			t.Errorf("change %d email = %q, want %q", i, change.email, want[i].email)
+		}
+	}
+}
+
+func TestApplyUsersRosterChanges(t *testing.T) {
+	t.Parallel()
+
+	var changes []usersRosterChange
+	for i := 0; i < 20; i++ {
+		changes = append(changes, usersRosterChange{kind: usersRosterCreate, key: fmt.Sprintf("user%d@example.com", i)})
+	}
+
+	var running, maxRunning atomic.Int32
+	results := applyUsersRosterChanges(context.Background(), changes, 3, func(_ context.Context, change usersRosterChange) (string, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		if change.key == "user7@example.com" {
+			return "", errors.New("invalid email")
+		}
+		return "id-" + change.key, nil
+	})
+
+	if maxRunning.Load() > 3 {
+		t.Errorf("expected at most 3 concurrent changes, got %d", maxRunning.Load())
+	}
+	if len(results) != len(changes) {
+		t.Fatalf("expected %d results, got %d", len(changes), len(results))
+	}
+	if results["user7@example.com"].err == nil {
+		t.Error("expected the failed change to be reported")
+	}
+	if results["user8@example.com"].err != nil || results["user8@example.com"].userID != "id-user8@example.com" {
+		t.Errorf("expected the other changes to succeed, got %+v", results["user8@example.com"])
+	}
+}
+
+func TestAccUsersRoster(t *testing.T) {
+	emailAddr := createRandomEmail()
+	emailAddr2 := createRandomEmail()
+	config := func(users string) string {
+		return fmt.Sprintf(`
+provider "temporalcloud" {
+
+}
+
+resource "temporalcloud_users_roster" "terraform" {
+  users = %s
+}
+`, users)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(fmt.Sprintf(`[{ email = "%s", account_access = "read" }]`, emailAddr)),
+				Check:  resource.TestCheckResourceAttr("temporalcloud_users_roster.terraform", "user_ids.%", "1"),
+			},
+			{
+				Config: config(fmt.Sprintf(`[{ email = "%s", account_access = "developer" }, { email = "%s", account_access = "read" }]`, emailAddr, emailAddr2)),
+				Check:  resource.TestCheckResourceAttr("temporalcloud_users_roster.terraform", "user_ids.%", "2"),
+			},
+			{
+				Config: config(fmt.Sprintf(`[{ email = "%s", account_access = "read" }]`, emailAddr2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporalcloud_users_roster.terraform", "user_ids.%", "1"),
+					resource.TestCheckResourceAttr("temporalcloud_users_roster.terraform", "adopted_user_ids.#", "0"),
+				),
+			},
+			{
+				Config:      config(fmt.Sprintf(`[{ email = "%s", account_access = "read" }, { email = "%s", account_access = "none" }]`, emailAddr2, createRandomEmail())),
+				ExpectError: regexp.MustCompile("Invalid Roster User"),
+			},
+		},
+	})
+}
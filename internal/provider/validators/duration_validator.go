@@ -0,0 +1,102 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var daysPattern = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+// maxDays is the largest number of days a time.Duration can hold.
+const maxDays = math.MaxInt64 / int64(24*time.Hour)
+
+// ParseDuration parses a Go duration string that may additionally start with a number of days,
+// such as "90d" or "1d12h".
+func ParseDuration(s string) (time.Duration, error) {
+	match := daysPattern.FindStringSubmatch(s)
+	if match == nil {
+		return time.ParseDuration(s)
+	}
+
+	days, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	if days > maxDays {
+		return 0, fmt.Errorf("invalid duration %q: more than %d days", s, maxDays)
+	}
+
+	d := time.Duration(days) * 24 * time.Hour
+	if match[2] == "" {
+		return d, nil
+	}
+
+	rest, err := time.ParseDuration(match[2])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	if rest < 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	if rest > math.MaxInt64-d {
+		return 0, fmt.Errorf("invalid duration %q: out of range", s)
+	}
+
+	return d + rest, nil
+}
+
+type durationValidator struct {
+	allowZero bool
+}
+
+// Duration returns a validator that checks if a string is a positive duration as accepted by ParseDuration.
+func Duration() validator.String {
+	return &durationValidator{}
+}
+
+// NonNegativeDuration returns a validator that checks if a string is a positive or zero duration as accepted
+// by ParseDuration.
+func NonNegativeDuration() validator.String {
+	return &durationValidator{allowZero: true}
+}
+
+func (v *durationValidator) Description(ctx context.Context) string {
+	if v.allowZero {
+		return "must be a non-negative duration such as 0s, 90d, 12h or 1d12h"
+	}
+	return "must be a positive duration such as 90d, 12h or 1d12h"
+}
+
+func (v *durationValidator) MarkdownDescription(ctx context.Context) string {
+	if v.allowZero {
+		return "must be a non-negative duration such as `0s`, `90d`, `12h` or `1d12h`"
+	}
+	return "must be a positive duration such as `90d`, `12h` or `1d12h`"
+}
+
+func (v *durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	d, err := ParseDuration(value)
+	if err != nil || d < 0 || (d == 0 && !v.allowZero) {
+		qualifier := "positive"
+		if v.allowZero {
+			qualifier = "non-negative"
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Duration %q must be a %s duration made of days (d), hours (h), minutes (m) or seconds (s), e.g. 90d or 1d12h.", value, qualifier),
+		)
+	}
+}
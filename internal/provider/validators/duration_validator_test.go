@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDuration(t *testing.T) {
+	testCases := []struct {
+		input     string
+		want      time.Duration
+		wantError bool
+	}{
+		{input: "90d", want: 90 * 24 * time.Hour},
+		{input: "1d12h", want: 36 * time.Hour},
+		{input: "12h30m", want: 12*time.Hour + 30*time.Minute},
+		{input: "0d", want: 0},
+		{input: "d", wantError: true},
+		{input: "1.5d", wantError: true},
+		{input: "1d-2h", wantError: true},
+		{input: "106751d23h", want: (106751*24 + 23) * time.Hour},
+		{input: "106751d24h", wantError: true},
+		{input: "106752d", wantError: true},
+		{input: "200000d", wantError: true},
+		{input: "99999999999999999999d", wantError: true},
+		{input: "", wantError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDuration(tc.input)
+			if tc.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDurationValidators(t *testing.T) {
+	testCases := []struct {
+		input                string
+		wantPositiveError    bool
+		wantNonNegativeError bool
+	}{
+		{input: "30d"},
+		{input: "1d12h"},
+		{input: "720h"},
+		{input: "0s", wantPositiveError: true},
+		{input: "0d", wantPositiveError: true},
+		{input: "-1h", wantPositiveError: true, wantNonNegativeError: true},
+		{input: "1w", wantPositiveError: true, wantNonNegativeError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			for _, v := range []struct {
+				validator validator.String
+				wantError bool
+			}{
+				{Duration(), tc.wantPositiveError},
+				{NonNegativeDuration(), tc.wantNonNegativeError},
+			} {
+				resp := &validator.StringResponse{}
+				v.validator.ValidateString(context.Background(), validator.StringRequest{
+					Path:        path.Root("duration"),
+					ConfigValue: types.StringValue(tc.input),
+				}, resp)
+				if resp.Diagnostics.HasError() != v.wantError {
+					t.Fatalf("%s: expected error %v, got %+v", v.validator.Description(context.Background()), v.wantError, resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package types
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	sinkv1 "go.temporal.io/cloud-sdk/api/sink/v1"
 )
 
 var (
@@ -94,3 +101,214 @@ type PubSubSpecModel struct {
 	// The service account email associated with the PubSub topic and service account
 	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
 }
+
+var serviceAccountEmailPattern = regexp.MustCompile(`^(\S+)@(\S+).iam.gserviceaccount.com$`)
+
+// ParseServiceAccountEmail splits a GCP service account email of the form
+// `<sa>@<gcp_project>.iam.gserviceaccount.com` into its service account ID and
+// project ID. Empty strings are returned when the email does not match.
+func ParseServiceAccountEmail(email string) (string, string) {
+	submatch := serviceAccountEmailPattern.FindStringSubmatch(email)
+	if len(submatch) != 3 {
+		return "", ""
+	}
+
+	return submatch[1], submatch[2]
+}
+
+func serviceAccountEmail(saId string, gcpProjectId string) string {
+	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", saId, gcpProjectId)
+}
+
+// resolveServiceAccount returns the service account ID and project ID, falling back
+// to parsing the service account email when neither is set explicitly.
+func resolveServiceAccount(saId types.String, gcpProjectId types.String, email types.String) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	id := saId.ValueString()
+	project := gcpProjectId.ValueString()
+	if id == "" && project == "" && email.ValueString() != "" {
+		id, project = ParseServiceAccountEmail(email.ValueString())
+	}
+
+	if id == "" || project == "" {
+		diags.AddError(
+			"Missing Service Account Configuration",
+			"Either provide both service_account_id and gcp_project_id, or provide a valid service_account_email",
+		)
+	}
+
+	return id, project, diags
+}
+
+// S3SpecToObject converts an S3 sink spec to its Terraform object representation.
+// A nil spec is converted to a null object.
+func S3SpecToObject(ctx context.Context, spec *sinkv1.S3Spec) (types.Object, diag.Diagnostics) {
+	if spec == nil {
+		return types.ObjectNull(S3SpecModelAttrTypes), nil
+	}
+
+	model := S3SpecModel{
+		RoleName:     types.StringValue(spec.GetRoleName()),
+		BucketName:   types.StringValue(spec.GetBucketName()),
+		Region:       types.StringValue(spec.GetRegion()),
+		KmsArn:       types.StringNull(),
+		AwsAccountId: types.StringValue(spec.GetAwsAccountId()),
+	}
+
+	if spec.GetKmsArn() != "" {
+		model.KmsArn = types.StringValue(spec.GetKmsArn())
+	}
+
+	return types.ObjectValueFrom(ctx, S3SpecModelAttrTypes, model)
+}
+
+// S3SpecFromObject converts a Terraform object to an S3 sink spec.
+// A null object is converted to a nil spec.
+func S3SpecFromObject(ctx context.Context, obj types.Object) (*sinkv1.S3Spec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() {
+		return nil, diags
+	}
+
+	var model S3SpecModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &sinkv1.S3Spec{
+		RoleName:     model.RoleName.ValueString(),
+		BucketName:   model.BucketName.ValueString(),
+		Region:       model.Region.ValueString(),
+		KmsArn:       model.KmsArn.ValueString(),
+		AwsAccountId: model.AwsAccountId.ValueString(),
+	}, diags
+}
+
+// GCSSpecToObject converts a GCS sink spec to its Terraform object representation.
+// A nil spec is converted to a null object.
+func GCSSpecToObject(ctx context.Context, spec *sinkv1.GCSSpec) (types.Object, diag.Diagnostics) {
+	if spec == nil {
+		return types.ObjectNull(GcsSpecModelAttrTypes), nil
+	}
+
+	model := GCSSpecModel{
+		SaId:                types.StringValue(spec.GetSaId()),
+		BucketName:          types.StringValue(spec.GetBucketName()),
+		GcpProjectId:        types.StringValue(spec.GetGcpProjectId()),
+		Region:              types.StringValue(spec.GetRegion()),
+		ServiceAccountEmail: types.StringValue(serviceAccountEmail(spec.GetSaId(), spec.GetGcpProjectId())),
+	}
+
+	return types.ObjectValueFrom(ctx, GcsSpecModelAttrTypes, model)
+}
+
+// GCSSpecFromObject converts a Terraform object to a GCS sink spec.
+// A null object is converted to a nil spec.
+func GCSSpecFromObject(ctx context.Context, obj types.Object) (*sinkv1.GCSSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() {
+		return nil, diags
+	}
+
+	var model GCSSpecModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	saId, gcpProjectId, d := resolveServiceAccount(model.SaId, model.GcpProjectId, model.ServiceAccountEmail)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &sinkv1.GCSSpec{
+		SaId:         saId,
+		BucketName:   model.BucketName.ValueString(),
+		GcpProjectId: gcpProjectId,
+		Region:       model.Region.ValueString(),
+	}, diags
+}
+
+// KinesisSpecToObject converts a Kinesis sink spec to its Terraform object representation.
+// A nil spec is converted to a null object.
+func KinesisSpecToObject(ctx context.Context, spec *sinkv1.KinesisSpec) (types.Object, diag.Diagnostics) {
+	if spec == nil {
+		return types.ObjectNull(KinesisSpecModelAttrTypes), nil
+	}
+
+	model := KinesisSpecModel{
+		RoleName:       types.StringValue(spec.GetRoleName()),
+		DestinationUri: types.StringValue(spec.GetDestinationUri()),
+		Region:         types.StringValue(spec.GetRegion()),
+	}
+
+	return types.ObjectValueFrom(ctx, KinesisSpecModelAttrTypes, model)
+}
+
+// KinesisSpecFromObject converts a Terraform object to a Kinesis sink spec.
+// A null object is converted to a nil spec.
+func KinesisSpecFromObject(ctx context.Context, obj types.Object) (*sinkv1.KinesisSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() {
+		return nil, diags
+	}
+
+	var model KinesisSpecModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &sinkv1.KinesisSpec{
+		RoleName:       model.RoleName.ValueString(),
+		DestinationUri: model.DestinationUri.ValueString(),
+		Region:         model.Region.ValueString(),
+	}, diags
+}
+
+// PubSubSpecToObject converts a PubSub sink spec to its Terraform object representation.
+// A nil spec is converted to a null object.
+func PubSubSpecToObject(ctx context.Context, spec *sinkv1.PubSubSpec) (types.Object, diag.Diagnostics) {
+	if spec == nil {
+		return types.ObjectNull(PubSubSpecModelAttrTypes), nil
+	}
+
+	model := PubSubSpecModel{
+		ServiceAccountId:    types.StringValue(spec.GetServiceAccountId()),
+		TopicName:           types.StringValue(spec.GetTopicName()),
+		GcpProjectId:        types.StringValue(spec.GetGcpProjectId()),
+		ServiceAccountEmail: types.StringValue(serviceAccountEmail(spec.GetServiceAccountId(), spec.GetGcpProjectId())),
+	}
+
+	return types.ObjectValueFrom(ctx, PubSubSpecModelAttrTypes, model)
+}
+
+// PubSubSpecFromObject converts a Terraform object to a PubSub sink spec.
+// A null object is converted to a nil spec.
+func PubSubSpecFromObject(ctx context.Context, obj types.Object) (*sinkv1.PubSubSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() {
+		return nil, diags
+	}
+
+	var model PubSubSpecModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	saId, gcpProjectId, d := resolveServiceAccount(model.ServiceAccountId, model.GcpProjectId, model.ServiceAccountEmail)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &sinkv1.PubSubSpec{
+		ServiceAccountId: saId,
+		TopicName:        model.TopicName.ValueString(),
+		GcpProjectId:     gcpProjectId,
+	}, diags
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sinkv1 "go.temporal.io/cloud-sdk/api/sink/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseServiceAccountEmail(t *testing.T) {
+	testCases := []struct {
+		Email           string
+		ExpectedSaId    string
+		ExpectedProject string
+	}{
+		{
+			Email:           "export-prod@prod-project.iam.gserviceaccount.com",
+			ExpectedSaId:    "export-prod",
+			ExpectedProject: "prod-project",
+		},
+		{
+			Email: "export-prod@example.com",
+		},
+		{
+			Email: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Email, func(t *testing.T) {
+			saId, project := ParseServiceAccountEmail(tc.Email)
+			if saId != tc.ExpectedSaId || project != tc.ExpectedProject {
+				t.Fatalf("expected (%q, %q), got (%q, %q)", tc.ExpectedSaId, tc.ExpectedProject, saId, project)
+			}
+		})
+	}
+}
+
+func TestSinkSpecRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("s3", func(t *testing.T) {
+		spec := &sinkv1.S3Spec{
+			RoleName:     "role",
+			BucketName:   "bucket",
+			Region:       "us-east-1",
+			KmsArn:       "arn:aws:kms:us-east-1:123456789012:key/abc",
+			AwsAccountId: "123456789012",
+		}
+
+		obj, diags := S3SpecToObject(ctx, spec)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		got, diags := S3SpecFromObject(ctx, obj)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !proto.Equal(spec, got) {
+			t.Fatalf("expected %v, got %v", spec, got)
+		}
+	})
+
+	t.Run("gcs", func(t *testing.T) {
+		spec := &sinkv1.GCSSpec{
+			SaId:         "export-prod",
+			BucketName:   "bucket",
+			GcpProjectId: "prod-project",
+			Region:       "us-central1",
+		}
+
+		obj, diags := GCSSpecToObject(ctx, spec)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		email := obj.Attributes()["service_account_email"].(types.String).ValueString()
+		if email != "export-prod@prod-project.iam.gserviceaccount.com" {
+			t.Fatalf("unexpected service account email: %s", email)
+		}
+
+		got, diags := GCSSpecFromObject(ctx, obj)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !proto.Equal(spec, got) {
+			t.Fatalf("expected %v, got %v", spec, got)
+		}
+	})
+
+	t.Run("kinesis", func(t *testing.T) {
+		spec := &sinkv1.KinesisSpec{
+			RoleName:       "role",
+			DestinationUri: "arn:aws:kinesis:us-east-1:123456789012:stream/audit",
+			Region:         "us-east-1",
+		}
+
+		obj, diags := KinesisSpecToObject(ctx, spec)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		got, diags := KinesisSpecFromObject(ctx, obj)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !proto.Equal(spec, got) {
+			t.Fatalf("expected %v, got %v", spec, got)
+		}
+	})
+
+	t.Run("pubsub", func(t *testing.T) {
+		spec := &sinkv1.PubSubSpec{
+			ServiceAccountId: "audit",
+			TopicName:        "topic",
+			GcpProjectId:     "prod-project",
+		}
+
+		obj, diags := PubSubSpecToObject(ctx, spec)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		got, diags := PubSubSpecFromObject(ctx, obj)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !proto.Equal(spec, got) {
+			t.Fatalf("expected %v, got %v", spec, got)
+		}
+	})
+}
+
+func TestSinkSpecFromObject_Null(t *testing.T) {
+	ctx := context.Background()
+
+	if spec, diags := S3SpecFromObject(ctx, types.ObjectNull(S3SpecModelAttrTypes)); spec != nil || diags.HasError() {
+		t.Fatalf("expected nil S3 spec, got %v (%v)", spec, diags)
+	}
+	if spec, diags := GCSSpecFromObject(ctx, types.ObjectNull(GcsSpecModelAttrTypes)); spec != nil || diags.HasError() {
+		t.Fatalf("expected nil GCS spec, got %v (%v)", spec, diags)
+	}
+	if spec, diags := KinesisSpecFromObject(ctx, types.ObjectNull(KinesisSpecModelAttrTypes)); spec != nil || diags.HasError() {
+		t.Fatalf("expected nil Kinesis spec, got %v (%v)", spec, diags)
+	}
+	if spec, diags := PubSubSpecFromObject(ctx, types.ObjectNull(PubSubSpecModelAttrTypes)); spec != nil || diags.HasError() {
+		t.Fatalf("expected nil PubSub spec, got %v (%v)", spec, diags)
+	}
+}
+
+func TestPubSubSpecFromObject_ServiceAccountEmail(t *testing.T) {
+	ctx := context.Background()
+
+	obj := types.ObjectValueMust(PubSubSpecModelAttrTypes, map[string]attr.Value{
+		"service_account_id":    types.StringUnknown(),
+		"topic_name":            types.StringValue("topic"),
+		"gcp_project_id":        types.StringUnknown(),
+		"service_account_email": types.StringValue("audit@prod-project.iam.gserviceaccount.com"),
+	})
+
+	spec, diags := PubSubSpecFromObject(ctx, obj)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if spec.GetServiceAccountId() != "audit" || spec.GetGcpProjectId() != "prod-project" {
+		t.Fatalf("unexpected service account resolution: %v", spec)
+	}
+
+	obj = types.ObjectValueMust(PubSubSpecModelAttrTypes, map[string]attr.Value{
+		"service_account_id":    types.StringNull(),
+		"topic_name":            types.StringValue("topic"),
+		"gcp_project_id":        types.StringNull(),
+		"service_account_email": types.StringNull(),
+	})
+
+	if _, diags := PubSubSpecFromObject(ctx, obj); !diags.HasError() {
+		t.Fatal("expected an error when no service account is configured")
+	}
+}
@@ -3,13 +3,16 @@ package validation
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type setFieldUnique struct {
-	attrFieldName string
+	attrFieldName   string
+	caseInsensitive bool
 }
 
 func SetNestedAttributeMustBeUnique(field string) validator.Set {
@@ -18,6 +21,15 @@ func SetNestedAttributeMustBeUnique(field string) validator.Set {
 	}
 }
 
+// SetNestedAttributeMustBeUniqueCaseInsensitive is like SetNestedAttributeMustBeUnique, but also treats values
+// that only differ in case as duplicates, such as email addresses.
+func SetNestedAttributeMustBeUniqueCaseInsensitive(field string) validator.Set {
+	return setFieldUnique{
+		attrFieldName:   field,
+		caseInsensitive: true,
+	}
+}
+
 func (s setFieldUnique) Description(_ context.Context) string {
 	return "Validates that a field in the set nested object is unique across all entries"
 }
@@ -111,7 +123,11 @@ func (s setFieldUnique) ValidateSet(ctx context.Context, req validator.SetReques
 		}
 
 		str := strValue.ValueString()
-		_, conflict := duplicates[str]
+		key := str
+		if s.caseInsensitive {
+			key = strings.ToLower(str)
+		}
+		_, conflict := duplicates[key]
 		tflog.Debug(ctx, "checking nested set attribute uniqueness", map[string]interface{}{
 			"path":       elementPath.String(),
 			"field_name": s.attrFieldName,
@@ -128,6 +144,6 @@ func (s setFieldUnique) ValidateSet(ctx context.Context, req validator.SetReques
 			return
 		}
 
-		duplicates[str] = struct{}{}
+		duplicates[key] = struct{}{}
 	}
 }